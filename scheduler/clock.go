@@ -0,0 +1,29 @@
+package scheduler
+
+import "time"
+
+// Clock abstracts the passage of time so the Scheduler can be tested without waiting.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// After returns a channel that receives the current time once d has elapsed.
+	After(d time.Duration) <-chan time.Time
+}
+
+// systemClock is the Clock backed by the time package.
+type systemClock struct{}
+
+// SystemClock returns the Clock used by a Scheduler when WithClock() has not been used.
+func SystemClock() Clock {
+	return systemClock{}
+}
+
+// Now implements the Clock interface.
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// After implements the Clock interface.
+func (systemClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
@@ -0,0 +1,335 @@
+// Package scheduler takes Volume Group Snapshots on a schedule and prunes them according to their Retention Policy.
+//
+// Each Entry of a Scheduler pairs a Volume Group with a Retention Policy and an interval. Snapshots are named after the
+// interval they belong to (ex: sched-20201013-150000), so restarting the Scheduler, or running several of them, does not
+// create duplicate snapshots.
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/silk-us/silk-sdp-go-sdk/silksdp"
+)
+
+// defaultPrefix is the prefix of the snapshot names when Entry.Prefix is empty.
+const defaultPrefix = "sched"
+
+// nameLayout formats the interval a snapshot belongs to in its name.
+const nameLayout = "20060102-150405"
+
+// Client is the part of *silksdp.Credentials used by the Scheduler.
+type Client interface {
+	CreateSnapshotCtx(ctx context.Context, volumeGroupName string, opts silksdp.SnapshotOptions, timeout ...int) (*silksdp.VolumeGroupSnapshot, error)
+	ListSnapshotsCtx(ctx context.Context, filter silksdp.SnapshotFilter, timeout ...int) ([]silksdp.VolumeGroupSnapshot, error)
+	DeleteVolumeGroupSnapshotCtx(ctx context.Context, name string, timeout ...int) (*silksdp.DeleteResponse, error)
+	FindRetentionPoliciesCtx(ctx context.Context, filter silksdp.ListFilter, timeout ...int) (*silksdp.GetRetentionPolicyResponse, error)
+}
+
+var _ Client = (*silksdp.Credentials)(nil)
+
+// Entry schedules the snapshots of one Volume Group.
+type Entry struct {
+	// VolumeGroup is the name of the Volume Group to snapshot.
+	VolumeGroup string
+	// RetentionPolicy is the name of the Retention Policy of the snapshots. Its NumSnapshots, Weeks, Days, and Hours
+	// limits decide which snapshots are pruned.
+	RetentionPolicy string
+	// Interval is the time between two snapshots. Snapshots are aligned on multiples of Interval since the Unix epoch,
+	// so an hourly Entry snapshots on the hour.
+	Interval time.Duration
+	// Prefix is the prefix of the snapshot names. It defaults to "sched". Only the snapshots whose name starts with
+	// the prefix are pruned.
+	Prefix string
+	// Exposable allows views of the snapshots to be created.
+	Exposable bool
+}
+
+// Result reports what the Scheduler did for one Entry.
+type Result struct {
+	Entry Entry
+	// Created is the name of the snapshot of the current interval, if it was created by this run or an earlier one.
+	Created string
+	// Pruned holds the names of the snapshots deleted.
+	Pruned []string
+	// Err is the first error met while handling the Entry.
+	Err error
+}
+
+// Option configures a Scheduler.
+type Option func(*Scheduler)
+
+// WithClock replaces the system clock, typically with a fake clock in tests.
+func WithClock(clock Clock) Option {
+	return func(s *Scheduler) {
+		s.clock = clock
+	}
+}
+
+// WithReporter registers a function called with the Result of every Entry handled by Run().
+func WithReporter(reporter func(Result)) Option {
+	return func(s *Scheduler) {
+		s.reporter = reporter
+	}
+}
+
+// Scheduler creates and prunes the snapshots of a set of Entries.
+type Scheduler struct {
+	client   Client
+	clock    Clock
+	reporter func(Result)
+	entries  []Entry
+	// last holds the interval of the last snapshot taken for each Entry, by index.
+	last map[int]time.Time
+}
+
+// New returns a Scheduler for the provided Entries. At least one Entry is required, and every Interval must be a whole
+// number of seconds.
+func New(client Client, entries []Entry, opts ...Option) (*Scheduler, error) {
+
+	if len(entries) == 0 {
+		return nil, errors.New("A Scheduler requires at least one Entry")
+	}
+
+	for _, entry := range entries {
+		if entry.VolumeGroup == "" || entry.RetentionPolicy == "" {
+			return nil, errors.New("Every Entry requires a VolumeGroup and a RetentionPolicy")
+		}
+		if entry.Interval < time.Second || entry.Interval%time.Second != 0 {
+			return nil, fmt.Errorf("The Interval of the Entry for the Volume Group '%s' must be a whole number of seconds, of at least one second", entry.VolumeGroup)
+		}
+	}
+
+	s := &Scheduler{
+		client:  client,
+		clock:   SystemClock(),
+		entries: append([]Entry{}, entries...),
+		last:    map[int]time.Time{},
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s, nil
+}
+
+// SnapshotName returns the name of the snapshot taken for the interval starting at slot.
+func SnapshotName(prefix string, slot time.Time) string {
+	if prefix == "" {
+		prefix = defaultPrefix
+	}
+	return fmt.Sprintf("%s-%s", prefix, slot.UTC().Format(nameLayout))
+}
+
+// Run calls RunOnce() every time an Entry is due, until ctx is done.
+func (s *Scheduler) Run(ctx context.Context) error {
+	for {
+		for _, result := range s.RunOnce(ctx) {
+			if s.reporter != nil {
+				s.reporter(result)
+			}
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-s.clock.After(s.untilNext()):
+		}
+	}
+}
+
+// RunOnce snapshots every Entry whose current interval has not been snapshotted yet and prunes its expired snapshots.
+// Entries that are not due are skipped and do not appear in the returned Results.
+func (s *Scheduler) RunOnce(ctx context.Context) []Result {
+
+	now := s.clock.Now()
+
+	var results []Result
+	for i, entry := range s.entries {
+		slot := slotOf(now, entry.Interval)
+		if last, ok := s.last[i]; ok && last.Equal(slot) {
+			continue
+		}
+
+		result := s.runEntry(ctx, entry, slot, now)
+		if result.Created != "" {
+			s.last[i] = slot
+		}
+		results = append(results, result)
+	}
+
+	return results
+}
+
+// untilNext returns the time left before the next Entry is due.
+func (s *Scheduler) untilNext() time.Duration {
+
+	now := s.clock.Now()
+
+	var next time.Duration
+	for i, entry := range s.entries {
+		slot := slotOf(now, entry.Interval)
+		wait := slot.Add(entry.Interval).Sub(now)
+		if last, ok := s.last[i]; ok == false || last.Equal(slot) == false {
+			// The snapshot of the current interval failed, retry it within a minute
+			if wait > time.Minute {
+				wait = time.Minute
+			}
+		}
+		if i == 0 || wait < next {
+			next = wait
+		}
+	}
+
+	return next
+}
+
+// slotOf returns the start of the interval containing now. Intervals are aligned on multiples of interval since the
+// Unix epoch, whereas time.Truncate() aligns them on the zero time.
+func slotOf(now time.Time, interval time.Duration) time.Time {
+	seconds := int64(interval / time.Second)
+	return time.Unix((now.Unix()/seconds)*seconds, 0)
+}
+
+// runEntry creates the snapshot of the provided interval and prunes the expired snapshots of the Entry.
+func (s *Scheduler) runEntry(ctx context.Context, entry Entry, slot, now time.Time) Result {
+
+	result := Result{Entry: entry}
+
+	name := SnapshotName(entry.Prefix, slot)
+	exists, err := s.snapshotExists(ctx, entry, name)
+	if err != nil {
+		result.Err = fmt.Errorf("Unable to list the snapshots of the Volume Group '%s': %w", entry.VolumeGroup, err)
+		return result
+	}
+	// The snapshot of this interval already exists when the Scheduler restarts within an interval
+	if exists == false {
+		_, err := s.client.CreateSnapshotCtx(ctx, entry.VolumeGroup, silksdp.SnapshotOptions{
+			Name:            name,
+			RetentionPolicy: entry.RetentionPolicy,
+			Deletable:       true,
+			Exposable:       entry.Exposable,
+		})
+		if err != nil {
+			result.Err = fmt.Errorf("Unable to snapshot the Volume Group '%s': %w", entry.VolumeGroup, err)
+			return result
+		}
+	}
+	result.Created = name
+
+	pruned, err := s.prune(ctx, entry, now)
+	result.Pruned = pruned
+	if err != nil {
+		result.Err = err
+	}
+
+	return result
+}
+
+// snapshotExists reports whether the Volume Group of the Entry already has a snapshot with the provided short name.
+func (s *Scheduler) snapshotExists(ctx context.Context, entry Entry, name string) (bool, error) {
+
+	snapshots, err := s.client.ListSnapshotsCtx(ctx, silksdp.SnapshotFilter{VolumeGroup: entry.VolumeGroup})
+	if err != nil {
+		return false, err
+	}
+
+	for _, snapshot := range snapshots {
+		if shortName(snapshot) == name {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// prune deletes the deletable snapshots of the Entry that exceed the limits of its Retention Policy.
+func (s *Scheduler) prune(ctx context.Context, entry Entry, now time.Time) ([]string, error) {
+
+	policies, err := s.client.FindRetentionPoliciesCtx(ctx, silksdp.Filter().Name().In(entry.RetentionPolicy))
+	if err != nil {
+		return nil, err
+	}
+
+	var policy *silksdp.RetentionPolicy
+	for i := range policies.Hits {
+		if policies.Hits[i].Name == entry.RetentionPolicy {
+			policy = &policies.Hits[i]
+		}
+	}
+	if policy == nil {
+		return nil, &silksdp.NotFoundError{Kind: silksdp.KindRetentionPolicy, Name: entry.RetentionPolicy}
+	}
+
+	snapshots, err := s.client.ListSnapshotsCtx(ctx, silksdp.SnapshotFilter{
+		VolumeGroup:     entry.VolumeGroup,
+		RetentionPolicy: entry.RetentionPolicy,
+		Deletable:       silksdp.Bool(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := entry.Prefix
+	if prefix == "" {
+		prefix = defaultPrefix
+	}
+
+	var owned []silksdp.VolumeGroupSnapshot
+	for _, snapshot := range snapshots {
+		if strings.HasPrefix(shortName(snapshot), prefix+"-") {
+			owned = append(owned, snapshot)
+		}
+	}
+
+	var pruned []string
+	for _, snapshot := range expired(owned, *policy, now) {
+		if _, err := s.client.DeleteVolumeGroupSnapshotCtx(ctx, snapshot.Name); err != nil {
+			return pruned, fmt.Errorf("Unable to prune the snapshot '%s': %w", snapshot.Name, err)
+		}
+		pruned = append(pruned, snapshot.Name)
+	}
+
+	return pruned, nil
+}
+
+// expired returns the snapshots that exceed the limits of the Retention Policy: the snapshots beyond the NumSnapshots
+// most recent ones, and the snapshots older than the sum of Weeks, Days, and Hours. A limit of 0 is not enforced.
+func expired(snapshots []silksdp.VolumeGroupSnapshot, policy silksdp.RetentionPolicy, now time.Time) []silksdp.VolumeGroupSnapshot {
+
+	sorted := append([]silksdp.VolumeGroupSnapshot{}, snapshots...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].CreationTime.After(sorted[j].CreationTime)
+	})
+
+	maxAge := time.Duration(policy.Weeks)*7*24*time.Hour + time.Duration(policy.Days)*24*time.Hour + time.Duration(policy.Hours)*time.Hour
+
+	var result []silksdp.VolumeGroupSnapshot
+	for i, snapshot := range sorted {
+		tooMany := policy.NumSnapshots > 0 && i >= policy.NumSnapshots
+		tooOld := maxAge > 0 && now.Sub(snapshot.CreationTime) > maxAge
+		if tooMany || tooOld {
+			result = append(result, snapshot)
+		}
+	}
+
+	return result
+}
+
+// shortName returns the name of the snapshot without the Volume Group prefix.
+func shortName(snapshot silksdp.VolumeGroupSnapshot) string {
+	if snapshot.ShortName != "" {
+		return snapshot.ShortName
+	}
+	if index := strings.LastIndex(snapshot.Name, ":"); index != -1 {
+		return snapshot.Name[index+1:]
+	}
+	return snapshot.Name
+}
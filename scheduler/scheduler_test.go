@@ -0,0 +1,214 @@
+package scheduler
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/silk-us/silk-sdp-go-sdk/silksdp"
+)
+
+// fakeClock is a Clock whose time only moves when the test advances it.
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.now = c.now.Add(d)
+	channel := make(chan time.Time, 1)
+	channel <- c.now
+	return channel
+}
+
+// fakeClient keeps the snapshots of a single Volume Group in memory.
+type fakeClient struct {
+	clock     *fakeClock
+	policy    silksdp.RetentionPolicy
+	snapshots map[string]silksdp.VolumeGroupSnapshot
+	creates   int
+}
+
+func newFakeClient(clock *fakeClock, policy silksdp.RetentionPolicy) *fakeClient {
+	return &fakeClient{clock: clock, policy: policy, snapshots: map[string]silksdp.VolumeGroupSnapshot{}}
+}
+
+func (f *fakeClient) add(name string, created time.Time, deletable bool) {
+	f.snapshots["vg01:"+name] = silksdp.VolumeGroupSnapshot{Name: "vg01:" + name, ShortName: name, CreationTime: created, IsAutoDeleteable: deletable}
+}
+
+func (f *fakeClient) CreateSnapshotCtx(ctx context.Context, volumeGroupName string, opts silksdp.SnapshotOptions, timeout ...int) (*silksdp.VolumeGroupSnapshot, error) {
+	f.creates++
+	if _, ok := f.snapshots[volumeGroupName+":"+opts.Name]; ok {
+		return nil, &silksdp.APIError{StatusCode: http.StatusConflict}
+	}
+	f.add(opts.Name, f.clock.now, opts.Deletable)
+	snapshot := f.snapshots[volumeGroupName+":"+opts.Name]
+	return &snapshot, nil
+}
+
+func (f *fakeClient) ListSnapshotsCtx(ctx context.Context, filter silksdp.SnapshotFilter, timeout ...int) ([]silksdp.VolumeGroupSnapshot, error) {
+	var snapshots []silksdp.VolumeGroupSnapshot
+	for _, snapshot := range f.snapshots {
+		if filter.Deletable == nil || snapshot.IsAutoDeleteable == *filter.Deletable {
+			snapshots = append(snapshots, snapshot)
+		}
+	}
+	return snapshots, nil
+}
+
+func (f *fakeClient) DeleteVolumeGroupSnapshotCtx(ctx context.Context, name string, timeout ...int) (*silksdp.DeleteResponse, error) {
+	delete(f.snapshots, name)
+	return &silksdp.DeleteResponse{StatusCode: 204}, nil
+}
+
+func (f *fakeClient) FindRetentionPoliciesCtx(ctx context.Context, filter silksdp.ListFilter, timeout ...int) (*silksdp.GetRetentionPolicyResponse, error) {
+	return &silksdp.GetRetentionPolicyResponse{Hits: []silksdp.RetentionPolicy{f.policy}, Total: 1}, nil
+}
+
+func (f *fakeClient) names() []string {
+	var names []string
+	for name := range f.snapshots {
+		names = append(names, strings.TrimPrefix(name, "vg01:"))
+	}
+	sort.Strings(names)
+	return names
+}
+
+func Test_SnapshotName(t *testing.T) {
+	slot := time.Date(2020, 10, 13, 15, 0, 0, 0, time.UTC)
+	if name := SnapshotName("", slot); name != "sched-20201013-150000" {
+		t.Errorf("Unexpected name: %s", name)
+	}
+	if name := SnapshotName("db", slot.In(time.FixedZone("CEST", 2*60*60))); name != "db-20201013-150000" {
+		t.Errorf("Expected the name to be in UTC, got %s", name)
+	}
+}
+
+func Test_NewValidatesEntries(t *testing.T) {
+	invalid := map[string][]Entry{
+		"no entries":          nil,
+		"sub-second interval": {{VolumeGroup: "vg01", RetentionPolicy: "hourly", Interval: 500 * time.Millisecond}},
+		"fractional interval": {{VolumeGroup: "vg01", RetentionPolicy: "hourly", Interval: 1500 * time.Millisecond}},
+		"no retention policy": {{VolumeGroup: "vg01", Interval: time.Hour}},
+	}
+	for name, entries := range invalid {
+		if _, err := New(&fakeClient{}, entries); err == nil {
+			t.Errorf("Expected New to refuse %s", name)
+		}
+	}
+}
+
+func Test_RunOnceIsIdempotentWithinAnInterval(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2020, 10, 13, 15, 20, 0, 0, time.UTC)}
+	client := newFakeClient(clock, silksdp.RetentionPolicy{Name: "hourly", NumSnapshots: 10})
+
+	scheduler, err := New(client, []Entry{{VolumeGroup: "vg01", RetentionPolicy: "hourly", Interval: time.Hour}}, WithClock(clock))
+	if err != nil {
+		t.Fatalf("Failed to create the scheduler: %v", err)
+	}
+
+	results := scheduler.RunOnce(context.Background())
+	if len(results) != 1 || results[0].Err != nil || results[0].Created != "sched-20201013-150000" {
+		t.Fatalf("Unexpected results: %+v", results)
+	}
+
+	clock.now = clock.now.Add(30 * time.Minute)
+	if results := scheduler.RunOnce(context.Background()); len(results) != 0 {
+		t.Errorf("Expected no snapshot before the next interval, got %+v", results)
+	}
+
+	// A restarted scheduler finds the snapshot of the current interval instead of creating it again
+	restarted, _ := New(client, []Entry{{VolumeGroup: "vg01", RetentionPolicy: "hourly", Interval: time.Hour}}, WithClock(clock))
+	if results := restarted.RunOnce(context.Background()); len(results) != 1 || results[0].Err != nil {
+		t.Errorf("Unexpected results after a restart: %+v", results)
+	}
+	if len(client.snapshots) != 1 || client.creates != 1 {
+		t.Errorf("Expected a single snapshot from a single request, got %v (%d requests)", client.names(), client.creates)
+	}
+}
+
+func Test_RunOnceAlignsOnTheUnixEpoch(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2020, 10, 13, 15, 20, 0, 0, time.FixedZone("CEST", 2*60*60))}
+	client := newFakeClient(clock, silksdp.RetentionPolicy{Name: "sevenhourly", NumSnapshots: 10})
+
+	// 2020-10-13 13:20 UTC is in the 7 hour interval starting at 13:00 UTC when counting from the Unix epoch
+	scheduler, _ := New(client, []Entry{{VolumeGroup: "vg01", RetentionPolicy: "sevenhourly", Interval: 7 * time.Hour}}, WithClock(clock))
+	results := scheduler.RunOnce(context.Background())
+	if len(results) != 1 || results[0].Err != nil || results[0].Created != "sched-20201013-130000" {
+		t.Errorf("Unexpected results: %+v", results)
+	}
+}
+
+func Test_PruneByCount(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2020, 10, 13, 15, 0, 0, 0, time.UTC)}
+	client := newFakeClient(clock, silksdp.RetentionPolicy{Name: "hourly", NumSnapshots: 3})
+	client.add("manual", clock.now.Add(-10*time.Hour), true)
+	client.add("sched-pinned", clock.now.Add(-9*time.Hour), false)
+
+	scheduler, _ := New(client, []Entry{{VolumeGroup: "vg01", RetentionPolicy: "hourly", Interval: time.Hour}}, WithClock(clock))
+
+	var pruned []string
+	for i := 0; i < 5; i++ {
+		for _, result := range scheduler.RunOnce(context.Background()) {
+			if result.Err != nil {
+				t.Fatalf("Unexpected error: %v", result.Err)
+			}
+			pruned = append(pruned, result.Pruned...)
+		}
+		clock.now = clock.now.Add(time.Hour)
+	}
+
+	expected := []string{"manual", "sched-20201013-170000", "sched-20201013-180000", "sched-20201013-190000", "sched-pinned"}
+	if strings.Join(client.names(), " ") != strings.Join(expected, " ") {
+		t.Errorf("Expected %v to be kept, got %v", expected, client.names())
+	}
+	if len(pruned) != 2 || pruned[0] != "vg01:sched-20201013-150000" || pruned[1] != "vg01:sched-20201013-160000" {
+		t.Errorf("Unexpected pruned snapshots: %v", pruned)
+	}
+}
+
+func Test_PruneByAge(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2020, 10, 13, 15, 0, 0, 0, time.UTC)}
+	client := newFakeClient(clock, silksdp.RetentionPolicy{Name: "daily", Days: 1, Hours: 12})
+	client.add("sched-20201011-150000", clock.now.Add(-48*time.Hour), true)
+	client.add("sched-20201012-150000", clock.now.Add(-24*time.Hour), true)
+
+	scheduler, _ := New(client, []Entry{{VolumeGroup: "vg01", RetentionPolicy: "daily", Interval: 24 * time.Hour}}, WithClock(clock))
+	results := scheduler.RunOnce(context.Background())
+
+	if len(results) != 1 || len(results[0].Pruned) != 1 || results[0].Pruned[0] != "vg01:sched-20201011-150000" {
+		t.Errorf("Expected only the snapshot older than 36 hours to be pruned, got %+v", results)
+	}
+}
+
+func Test_Run(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2020, 10, 13, 15, 59, 0, 0, time.UTC)}
+	client := newFakeClient(clock, silksdp.RetentionPolicy{Name: "hourly", NumSnapshots: 24})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var created []string
+	scheduler, _ := New(client, []Entry{{VolumeGroup: "vg01", RetentionPolicy: "hourly", Interval: time.Hour}}, WithClock(clock), WithReporter(func(result Result) {
+		created = append(created, result.Created)
+		if len(created) == 3 {
+			cancel()
+		}
+	}))
+
+	if err := scheduler.Run(ctx); err != context.Canceled {
+		t.Errorf("Expected Run to stop when the context is cancelled, got %v", err)
+	}
+	expected := "sched-20201013-150000 sched-20201013-160000 sched-20201013-170000"
+	if strings.Join(created, " ") != expected {
+		t.Errorf("Expected %s, got %v", expected, created)
+	}
+	if client.creates != 3 {
+		t.Errorf("Expected one snapshot per interval, got %d requests", client.creates)
+	}
+}
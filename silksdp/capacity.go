@@ -0,0 +1,128 @@
+package silksdp
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Capacity is an amount of storage as used by the Silk server, which expresses sizes, quotas, and capacities in KiB.
+// Build one with KiB(), MiB(), GiB(), or TiB(), or parse a human readable value with ParseCapacity().
+type Capacity int64
+
+// Capacity units, in KiB.
+const (
+	kibibyte Capacity = 1
+	mebibyte          = 1024 * kibibyte
+	gibibyte          = 1024 * mebibyte
+	tebibyte          = 1024 * gibibyte
+	pebibyte          = 1024 * tebibyte
+)
+
+// capacityUnits maps the suffixes accepted by ParseCapacity() to their size. Suffixes are matched case-insensitively
+// and decimal suffixes (ex: GB) are treated as their binary equivalent, matching the Silk UI.
+var capacityUnits = map[string]Capacity{
+	"":    kibibyte,
+	"k":   kibibyte,
+	"kb":  kibibyte,
+	"kib": kibibyte,
+	"m":   mebibyte,
+	"mb":  mebibyte,
+	"mib": mebibyte,
+	"g":   gibibyte,
+	"gb":  gibibyte,
+	"gib": gibibyte,
+	"t":   tebibyte,
+	"tb":  tebibyte,
+	"tib": tebibyte,
+	"p":   pebibyte,
+	"pb":  pebibyte,
+	"pib": pebibyte,
+}
+
+// KiB returns a Capacity of n kibibytes.
+func KiB(n int64) Capacity {
+	return Capacity(n) * kibibyte
+}
+
+// MiB returns a Capacity of n mebibytes.
+func MiB(n int64) Capacity {
+	return Capacity(n) * mebibyte
+}
+
+// GiB returns a Capacity of n gibibytes.
+func GiB(n int64) Capacity {
+	return Capacity(n) * gibibyte
+}
+
+// TiB returns a Capacity of n tebibytes.
+func TiB(n int64) Capacity {
+	return Capacity(n) * tebibyte
+}
+
+// ParseCapacity parses a human readable capacity such as "500G", "2TiB", or "1.5 TB". A value without a unit is in
+// KiB, the unit used by the Silk server.
+func ParseCapacity(s string) (Capacity, error) {
+	value := strings.TrimSpace(s)
+
+	split := len(value)
+	for split > 0 && (value[split-1] < '0' || value[split-1] > '9') && value[split-1] != '.' {
+		split--
+	}
+
+	number := strings.TrimSpace(value[:split])
+	unit, ok := capacityUnits[strings.ToLower(strings.TrimSpace(value[split:]))]
+	if number == "" || ok == false {
+		return 0, fmt.Errorf("'%s' is not a valid capacity (ex: 500G, 2TiB)", s)
+	}
+
+	amount, err := strconv.ParseFloat(number, 64)
+	if err != nil || amount < 0 {
+		return 0, fmt.Errorf("'%s' is not a valid capacity (ex: 500G, 2TiB)", s)
+	}
+
+	// 2^63 KiB does not fit in a Capacity, checking before multiplying keeps the conversion from wrapping around
+	if amount >= math.MaxInt64/float64(unit) {
+		return 0, fmt.Errorf("'%s' exceeds the largest supported capacity", s)
+	}
+
+	return Capacity(amount * float64(unit)), nil
+}
+
+// KiB returns the Capacity in kibibytes, the unit used by the Silk server.
+func (c Capacity) KiB() int64 {
+	return int64(c)
+}
+
+// Bytes returns the Capacity in bytes.
+func (c Capacity) Bytes() int64 {
+	return int64(c) * 1024
+}
+
+// GiB returns the Capacity in gibibytes.
+func (c Capacity) GiB() float64 {
+	return float64(c) / float64(gibibyte)
+}
+
+// String formats the Capacity in the largest unit it fills, rounded to two decimals (ex: 10GiB, 1.5TiB).
+func (c Capacity) String() string {
+	units := []struct {
+		size   Capacity
+		suffix string
+	}{
+		{pebibyte, "PiB"},
+		{tebibyte, "TiB"},
+		{gibibyte, "GiB"},
+		{mebibyte, "MiB"},
+	}
+
+	for _, unit := range units {
+		if c >= unit.size || -c >= unit.size {
+			value := strconv.FormatFloat(float64(c)/float64(unit.size), 'f', 2, 64)
+			return strings.TrimSuffix(strings.TrimRight(value, "0"), ".") + unit.suffix
+		}
+	}
+
+	return strconv.FormatInt(int64(c), 10) + "KiB"
+}
@@ -1,22 +1,26 @@
 package silksdp
 
 import (
+	"context"
 	"fmt"
-
-	"github.com/mitchellh/mapstructure"
 )
 
 // GetCapacityPolicy returns information on all Capacity Policys found on the Silk server.
 func (c *Credentials) GetCapacityPolicy(timeout ...int) (*GetCapacityPolicyResponse, error) {
+	return c.GetCapacityPolicyCtx(context.Background(), timeout...)
+}
+
+// GetCapacityPolicyCtx is the context-aware form of GetCapacityPolicy.
+func (c *Credentials) GetCapacityPolicyCtx(ctx context.Context, timeout ...int) (*GetCapacityPolicyResponse, error) {
 	httpTimeout := httpTimeout(timeout)
 
-	apiRequest, err := c.Get("/vg_capacity_policies", httpTimeout)
+	apiRequest, err := c.getAllCtx(ctx, "/vg_capacity_policies", nil, httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 	// Convert the API Response (map[string]interface{}) to a struct
 	var apiResponse GetCapacityPolicyResponse
-	mapErr := mapstructure.Decode(apiRequest, &apiResponse)
+	mapErr := decode(apiRequest, &apiResponse)
 	if mapErr != nil {
 		return nil, mapErr
 	}
@@ -24,36 +28,50 @@ func (c *Credentials) GetCapacityPolicy(timeout ...int) (*GetCapacityPolicyRespo
 	return &apiResponse, nil
 }
 
-// GetCapacityPolicyID collects the capacity policy ID
-func (c *Credentials) GetCapacityPolicyID(name string, timeout ...int) (int, error) {
+// FindCapacityPolicies returns the Capacity Policies matching the provided filter, fetching every page of the
+// results (ex: silksdp.Filter().Name().Contains("gold")).
+func (c *Credentials) FindCapacityPolicies(filter ListFilter, timeout ...int) (*GetCapacityPolicyResponse, error) {
+	return c.FindCapacityPoliciesCtx(context.Background(), filter, timeout...)
+}
 
-	httpTimeout := httpTimeout(timeout)
+// FindCapacityPoliciesCtx is the context-aware form of FindCapacityPolicies.
+func (c *Credentials) FindCapacityPoliciesCtx(ctx context.Context, filter ListFilter, timeout ...int) (*GetCapacityPolicyResponse, error) {
 
-	objectsOnServer, err := c.GetCapacityPolicy(httpTimeout)
+	apiRequest, err := c.getAllCtx(ctx, "/vg_capacity_policies", filter, httpTimeout(timeout))
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
-	// Set objectID to a value (-1) that can not be returned by the server
-	objectID := -1
-	for _, object := range objectsOnServer.Hits {
-		if object.Name == name {
-			objectID = object.ID
-		}
-
+	// Convert the API Response (map[string]interface{}) to a struct
+	var apiResponse GetCapacityPolicyResponse
+	mapErr := decode(apiRequest, &apiResponse)
+	if mapErr != nil {
+		return nil, mapErr
 	}
 
-	// If the objectID has not been updated (i.e not found on the server) return an error message
-	if objectID == -1 {
-		return 0, fmt.Errorf("The server does not contain a Capacity Policy named '%s'", name)
-	}
+	return &apiResponse, nil
+}
+
+// GetCapacityPolicyID collects the capacity policy ID
+func (c *Credentials) GetCapacityPolicyID(name string, timeout ...int) (int, error) {
+	return c.GetCapacityPolicyIDCtx(context.Background(), name, timeout...)
+}
+
+// GetCapacityPolicyIDCtx is the context-aware form of GetCapacityPolicyID.
+func (c *Credentials) GetCapacityPolicyIDCtx(ctx context.Context, name string, timeout ...int) (int, error) {
 
-	return objectID, nil
+	httpTimeout := httpTimeout(timeout)
 
+	return c.lookupID(ctx, KindCapacityPolicy, name, httpTimeout)
 }
 
 // CreateCapacityPolicy creates a new Capacity Policy on the Silk server.
 func (c *Credentials) CreateCapacityPolicy(name string, warningthreshold int, errorthreshold int, criticalthreshold int, fullthreshold int, snapshotoverheadthreshold int, timeout ...int) (*CreateOrUpdateCapacityPolicyResponse, error) {
+	return c.CreateCapacityPolicyCtx(context.Background(), name, warningthreshold, errorthreshold, criticalthreshold, fullthreshold, snapshotoverheadthreshold, timeout...)
+}
+
+// CreateCapacityPolicyCtx is the context-aware form of CreateCapacityPolicy.
+func (c *Credentials) CreateCapacityPolicyCtx(ctx context.Context, name string, warningthreshold int, errorthreshold int, criticalthreshold int, fullthreshold int, snapshotoverheadthreshold int, timeout ...int) (*CreateOrUpdateCapacityPolicyResponse, error) {
 
 	httpTimeout := httpTimeout(timeout)
 
@@ -65,13 +83,13 @@ func (c *Credentials) CreateCapacityPolicy(name string, warningthreshold int, er
 	config["full_threshold"] = fullthreshold
 	config["snapshot_overhead_threshold"] = snapshotoverheadthreshold
 
-	apiRequest, err := c.Post("/vg_capacity_policies", config, httpTimeout)
+	apiRequest, err := c.PostCtx(ctx, "/vg_capacity_policies", config, httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 
 	var apiResponse CreateOrUpdateCapacityPolicyResponse
-	mapErr := mapstructure.Decode(apiRequest, &apiResponse)
+	mapErr := decode(apiRequest, &apiResponse)
 	if mapErr != nil {
 		return nil, mapErr
 	}
@@ -79,17 +97,42 @@ func (c *Credentials) CreateCapacityPolicy(name string, warningthreshold int, er
 	return &apiResponse, nil
 }
 
+// CapacityPolicyUpdate holds the changes applied to a Capacity Policy by ApplyCapacityPolicyUpdate(). Only the fields
+// that are set are sent to the Silk server. Thresholds are percentages of the Volume Group quota.
+type CapacityPolicyUpdate struct {
+	// Name renames the Capacity Policy.
+	Name *string
+	// WarningThreshold sets the usage percentage that raises a warning.
+	WarningThreshold *int
+	// ErrorThreshold sets the usage percentage that raises an error.
+	ErrorThreshold *int
+	// CriticalThreshold sets the usage percentage that raises a critical alert.
+	CriticalThreshold *int
+	// FullThreshold sets the usage percentage at which the Volume Group is considered full.
+	FullThreshold *int
+	// SnapshotOverheadThreshold sets the percentage of the capacity that snapshots may use.
+	SnapshotOverheadThreshold *int
+}
+
 // UpdateCapacityPolicy updates the Capacity Policy with the provided config options.
 //
 // Valid config keys are: "name", "warningthreshold", "errorthreshold", "criticalthreshold", "fullthreshold", "snapshotoverheadthreshold".
+//
+// Deprecated: Use ApplyCapacityPolicyUpdate() and a CapacityPolicyUpdate, which are validated at compile time.
 func (c *Credentials) UpdateCapacityPolicy(name string, config map[string]interface{}, timeout ...int) (*CreateOrUpdateCapacityPolicyResponse, error) {
+	return c.UpdateCapacityPolicyCtx(context.Background(), name, config, timeout...)
+}
+
+// UpdateCapacityPolicyCtx is the context-aware form of UpdateCapacityPolicy.
+//
+// Deprecated: Use ApplyCapacityPolicyUpdateCtx() and a CapacityPolicyUpdate, which are validated at compile time.
+func (c *Credentials) UpdateCapacityPolicyCtx(ctx context.Context, name string, config map[string]interface{}, timeout ...int) (*CreateOrUpdateCapacityPolicyResponse, error) {
 	httpTimeout := httpTimeout(timeout)
 
 	// Validate that the user provided keys are valid for this API
 	validUpdateKeys := []string{"name", "warningthreshold", "errorthreshold", "criticalthreshold", "fullthreshold", "snapshotoverheadthreshold"}
 	var invalidUserProvidedKeys []string
 	for key := range config {
-
 		if c.stringInSlice(validUpdateKeys, key) == false {
 			invalidUserProvidedKeys = append(invalidUserProvidedKeys, key)
 		}
@@ -100,19 +143,61 @@ func (c *Credentials) UpdateCapacityPolicy(name string, config map[string]interf
 		return nil, fmt.Errorf("The provided 'config' parameter contains invalid keys. 'name', 'warningthreshold', 'errorthreshold', 'criticalthreshold', 'fullthreshold', 'snapshotoverheadthreshold' are the only valid choices")
 	}
 
-	CapacityPolicyID, err := c.GetCapacityPolicyID(name, httpTimeout)
+	return c.updateCapacityPolicy(ctx, name, config, httpTimeout)
+}
+
+// ApplyCapacityPolicyUpdate applies the provided changes to a Capacity Policy. Only the fields of the update that are
+// set are sent to the Silk server, and an error is returned if none are.
+func (c *Credentials) ApplyCapacityPolicyUpdate(name string, update CapacityPolicyUpdate, timeout ...int) (*CreateOrUpdateCapacityPolicyResponse, error) {
+	return c.ApplyCapacityPolicyUpdateCtx(context.Background(), name, update, timeout...)
+}
+
+// ApplyCapacityPolicyUpdateCtx is the context-aware form of ApplyCapacityPolicyUpdate.
+func (c *Credentials) ApplyCapacityPolicyUpdateCtx(ctx context.Context, name string, update CapacityPolicyUpdate, timeout ...int) (*CreateOrUpdateCapacityPolicyResponse, error) {
+
+	config := map[string]interface{}{}
+	if update.Name != nil {
+		config["name"] = *update.Name
+	}
+	if update.WarningThreshold != nil {
+		config["warning_threshold"] = *update.WarningThreshold
+	}
+	if update.ErrorThreshold != nil {
+		config["error_threshold"] = *update.ErrorThreshold
+	}
+	if update.CriticalThreshold != nil {
+		config["critical_threshold"] = *update.CriticalThreshold
+	}
+	if update.FullThreshold != nil {
+		config["full_threshold"] = *update.FullThreshold
+	}
+	if update.SnapshotOverheadThreshold != nil {
+		config["snapshot_overhead_threshold"] = *update.SnapshotOverheadThreshold
+	}
+
+	if len(config) == 0 {
+		return nil, errNoChanges
+	}
+
+	return c.updateCapacityPolicy(ctx, name, config, httpTimeout(timeout))
+}
+
+// updateCapacityPolicy sends the PATCH request shared by UpdateCapacityPolicyCtx() and ApplyCapacityPolicyUpdateCtx().
+func (c *Credentials) updateCapacityPolicy(ctx context.Context, name string, config map[string]interface{}, httpTimeout int) (*CreateOrUpdateCapacityPolicyResponse, error) {
+
+	CapacityPolicyID, err := c.GetCapacityPolicyIDCtx(ctx, name, httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 
-	apiRequest, err := c.Patch(fmt.Sprintf("/vg_capacity_policies/%d", CapacityPolicyID), config, httpTimeout)
+	apiRequest, err := c.PatchCtx(ctx, fmt.Sprintf("/vg_capacity_policies/%d", CapacityPolicyID), config, httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 
 	// Convert the API Response (map[string]interface{}) to a struct
 	var apiResponse CreateOrUpdateCapacityPolicyResponse
-	mapErr := mapstructure.Decode(apiRequest, &apiResponse)
+	mapErr := decode(apiRequest, &apiResponse)
 	if mapErr != nil {
 		return nil, mapErr
 	}
@@ -123,22 +208,27 @@ func (c *Credentials) UpdateCapacityPolicy(name string, config map[string]interf
 
 // DeleteCapacityPolicy deletes a Capacity Policy from the Silk server.
 func (c *Credentials) DeleteCapacityPolicy(name string, timeout ...int) (*DeleteResponse, error) {
+	return c.DeleteCapacityPolicyCtx(context.Background(), name, timeout...)
+}
+
+// DeleteCapacityPolicyCtx is the context-aware form of DeleteCapacityPolicy.
+func (c *Credentials) DeleteCapacityPolicyCtx(ctx context.Context, name string, timeout ...int) (*DeleteResponse, error) {
 
 	httpTimeout := httpTimeout(timeout)
 
-	CapacityPolicyID, err := c.GetCapacityPolicyID(name, httpTimeout)
+	CapacityPolicyID, err := c.GetCapacityPolicyIDCtx(ctx, name, httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 
-	apiRequest, err := c.Delete(fmt.Sprintf("/vg_capacity_policies/%d", CapacityPolicyID), httpTimeout)
+	apiRequest, err := c.DeleteCtx(ctx, fmt.Sprintf("/vg_capacity_policies/%d", CapacityPolicyID), httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 
 	// Convert the API Response (map[string]interface{}) to a struct
 	var apiResponse DeleteResponse
-	mapErr := mapstructure.Decode(apiRequest, &apiResponse)
+	mapErr := decode(apiRequest, &apiResponse)
 	if mapErr != nil {
 		return nil, mapErr
 	}
@@ -146,22 +236,26 @@ func (c *Credentials) DeleteCapacityPolicy(name string, timeout ...int) (*Delete
 	return &apiResponse, nil
 
 }
+
 // GetCapacityPolicyByName returns information on all Capacity Policys found on the Silk server.
 func (c *Credentials) GetCapacityPolicyByName(capacitypolicyname string, timeout ...int) (*GetCapacityPolicyResponse, error) {
-	httpTimeout := httpTimeout(timeout)
+	return c.GetCapacityPolicyByNameCtx(context.Background(), capacitypolicyname, timeout...)
+}
 
-	enduri := ("/vg_capacity_policies?name__contains=" + capacitypolicyname)
+// GetCapacityPolicyByNameCtx is the context-aware form of GetCapacityPolicyByName.
+func (c *Credentials) GetCapacityPolicyByNameCtx(ctx context.Context, capacitypolicyname string, timeout ...int) (*GetCapacityPolicyResponse, error) {
+	httpTimeout := httpTimeout(timeout)
 
-	apiRequest, err := c.Get(enduri, httpTimeout)
+	apiRequest, err := c.getAllCtx(ctx, "/vg_capacity_policies", Filter().Name().Contains(capacitypolicyname), httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 	// Convert the API Response (map[string]interface{}) to a struct
 	var apiResponse GetCapacityPolicyResponse
-	mapErr := mapstructure.Decode(apiRequest, &apiResponse)
+	mapErr := decode(apiRequest, &apiResponse)
 	if mapErr != nil {
 		return nil, mapErr
 	}
 
 	return &apiResponse, nil
-}
\ No newline at end of file
+}
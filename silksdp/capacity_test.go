@@ -0,0 +1,74 @@
+package silksdp
+
+import (
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+)
+
+func Test_ParseCapacity(t *testing.T) {
+	tests := map[string]Capacity{
+		"500G":   GiB(500),
+		"2TiB":   TiB(2),
+		"1.5 TB": TiB(3) / 2,
+		"64mb":   MiB(64),
+		"4096":   KiB(4096),
+	}
+	for input, expected := range tests {
+		capacity, err := ParseCapacity(input)
+		if err != nil {
+			t.Errorf("Failed to parse %q: %v", input, err)
+		} else if capacity != expected {
+			t.Errorf("Expected %q to parse as %d KiB, got %d KiB", input, expected, capacity)
+		}
+	}
+
+	for _, input := range []string{"", "G", "10 bytes", "-1G"} {
+		if _, err := ParseCapacity(input); err == nil {
+			t.Errorf("Expected an error when parsing %q", input)
+		}
+	}
+}
+
+func Test_ParseCapacityOverflow(t *testing.T) {
+	for _, input := range []string{"9000000000 TiB", "8388608P", "9223372036854775808"} {
+		if capacity, err := ParseCapacity(input); err == nil {
+			t.Errorf("Expected %q to overflow, got %d KiB", input, capacity)
+		}
+	}
+
+	// The largest whole number of PiB that fits
+	if capacity, err := ParseCapacity("8388607P"); err != nil || capacity != 8388607*pebibyte {
+		t.Errorf("Expected 8388607P to parse, got %d KiB (%v)", capacity, err)
+	}
+}
+
+func Test_CapacityString(t *testing.T) {
+	tests := map[Capacity]string{
+		GiB(10):    "10GiB",
+		TiB(3) / 2: "1.5TiB",
+		MiB(1) + 1: "1MiB",
+		KiB(512):   "512KiB",
+		TiB(1024):  "1PiB",
+		GiB(1) / 3: "341.33MiB",
+	}
+	for capacity, expected := range tests {
+		if capacity.String() != expected {
+			t.Errorf("Expected %d KiB to format as %s, got %s", capacity, expected, capacity)
+		}
+	}
+}
+
+func Test_CapacityDecode(t *testing.T) {
+	var volume Volume
+	if err := mapstructure.Decode(map[string]interface{}{"size": float64(10485760), "logical_capacity": nil}, &volume); err != nil {
+		t.Fatalf("Failed to decode the volume: %v", err)
+	}
+	if volume.Size != GiB(10) || volume.LogicalCapacity != 0 {
+		t.Errorf("Unexpected capacities: size %s, logical capacity %s", volume.Size, volume.LogicalCapacity)
+	}
+
+	if encoded := Filter().Field("size").GreaterThan(TiB(1)).Encode(); encoded != "size__gt=1073741824" {
+		t.Errorf("Expected the capacity to be encoded in KiB, got %s", encoded)
+	}
+}
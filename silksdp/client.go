@@ -4,16 +4,19 @@ package silksdp
 
 import (
 	"bytes"
-	"crypto/tls"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net"
 	"net/http"
 	"os"
 	"reflect"
 	"sort"
+	"strconv"
+	"sync"
 	"time"
 )
 
@@ -27,16 +30,28 @@ const (
 )
 
 // Credentials contains the parameters used to authenticate against the Silk SDP server and can be consumed
-// through ConnectEnv() or Connect().
+// through ConnectEnv(), Connect(), or NewClient().
+//
+// A Credentials value holds a long-lived HTTP client so connections to the Silk SDP server are reused across calls. It
+// is safe for concurrent use and should be created once and shared.
 type Credentials struct {
 	Server   string
 	Username string
 	Password string
+
+	httpClient     *http.Client
+	httpClientOnce sync.Once
+	pollInterval   time.Duration
+	retry          *RetryPolicy
+	limiter        *Limiter
 }
 
 // Connect initializes a new API client based on manually provided Silk SDP server credentials. When possible,
 // the Silk credentials should not be stored as plain text in your .go file. ConnectEnv() can be used
 // as a safer alternative.
+//
+// The Silk SDP server certificate is verified against the system certificate pool. NewClient() accepts ClientOption
+// values to trust a custom CA, pin the server certificate, or configure a proxy.
 func Connect(server, username, password string) *Credentials {
 	client := &Credentials{
 		Server:   server,
@@ -47,6 +62,28 @@ func Connect(server, username, password string) *Credentials {
 	return client
 }
 
+// NewClient initializes a new API client based on manually provided Silk SDP server credentials and the provided
+// ClientOption values. An error is returned if any of the options are invalid (ex: an unreadable CA bundle).
+func NewClient(server, username, password string, opts ...ClientOption) (*Credentials, error) {
+
+	config, err := newClientConfig(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	client := &Credentials{
+		Server:       server,
+		Username:     username,
+		Password:     password,
+		httpClient:   config.newHTTPClient(),
+		pollInterval: config.pollInterval,
+		retry:        config.retryPolicy,
+		limiter:      config.newLimiter(),
+	}
+
+	return client, nil
+}
+
 // ConnectEnv is the preferred method to initialize a new API client by attempting to read the
 // following environment variables:
 //
@@ -55,7 +92,15 @@ func Connect(server, username, password string) *Credentials {
 //	SILK_SDP_USERNAME
 //
 //	SILK_SDP_PASSWORD
-func ConnectEnv() (*Credentials, error) {
+//
+// The following optional environment variables configure certificate verification:
+//
+//	SILK_SDP_CA_BUNDLE - path to a PEM encoded CA bundle used to verify the server certificate
+//
+//	SILK_SDP_INSECURE - set to "true" to disable the verification of the server certificate
+//
+// Any provided ClientOption values are applied after the environment variables.
+func ConnectEnv(opts ...ClientOption) (*Credentials, error) {
 
 	server, ok := os.LookupEnv("SILK_SDP_SERVER")
 	if ok != true {
@@ -70,17 +115,69 @@ func ConnectEnv() (*Credentials, error) {
 		return nil, errors.New("The `SILK_SDP_PASSWORD` environment variable is not present")
 	}
 
-	client := &Credentials{
-		Server:   server,
-		Username: username,
-		Password: password,
+	var envOpts []ClientOption
+	if caBundle, ok := os.LookupEnv("SILK_SDP_CA_BUNDLE"); ok {
+		envOpts = append(envOpts, WithCABundle(caBundle))
+	}
+	if insecure, ok := os.LookupEnv("SILK_SDP_INSECURE"); ok {
+		insecureSkipVerify, err := strconv.ParseBool(insecure)
+		if err != nil {
+			return nil, fmt.Errorf("The `SILK_SDP_INSECURE` environment variable must be 'true' or 'false'")
+		}
+		if insecureSkipVerify {
+			envOpts = append(envOpts, WithInsecureSkipVerify())
+		}
+	}
+
+	return NewClient(server, username, password, append(envOpts, opts...)...)
+}
+
+// Dial is the fail-fast form of NewClient(). It calls Ping() before returning, so a wrong server address, username, or
+// password is reported immediately instead of on the first real call.
+func Dial(ctx context.Context, server, username, password string, opts ...ClientOption) (*Credentials, error) {
+
+	client, err := NewClient(server, username, password, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.PingCtx(ctx); err != nil {
+		return nil, err
+	}
+
+	return client, nil
+}
+
+// DialEnv is the fail-fast form of ConnectEnv(). It reads the same environment variables and calls Ping() before
+// returning.
+func DialEnv(ctx context.Context, opts ...ClientOption) (*Credentials, error) {
+
+	client, err := ConnectEnv(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.PingCtx(ctx); err != nil {
+		return nil, err
 	}
 
 	return client, nil
 }
 
-// makeHTTPCall consolidates the functionality for the GET, POST, PATCH, and DELETE functions.
-func (c *Credentials) makeHTTPCall(callType, apiEndpoint string, config interface{}, timeout int) (interface{}, error) {
+// client returns the HTTP client shared by every request. Credentials created without NewClient() (ex: through
+// Connect() or a struct literal) lazily receive a client with the default settings.
+func (c *Credentials) client() *http.Client {
+	c.httpClientOnce.Do(func() {
+		if c.httpClient == nil {
+			c.httpClient = defaultClientConfig().newHTTPClient()
+		}
+	})
+	return c.httpClient
+}
+
+// makeHTTPCall consolidates the functionality for the GET, POST, PATCH, and DELETE functions. The optional rawQuery is
+// appended to GET requests as-is and must already be URL encoded (see Query.Encode()).
+func (c *Credentials) makeHTTPCall(ctx context.Context, callType, apiEndpoint, rawQuery string, config interface{}, timeout int) (interface{}, error) {
 
 	if endpointValidation(apiEndpoint) == "errorStart" {
 		return nil, errors.New("The API Endpoint should begin with '/' (ex: /cluster/me)")
@@ -88,76 +185,141 @@ func (c *Credentials) makeHTTPCall(callType, apiEndpoint string, config interfac
 		return nil, errors.New("The API Endpoint should not end with '/' (ex. /cluster/me)")
 	}
 
-	tr := &http.Transport{
-		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
-	}
-	client := &http.Client{
-		Transport: tr,
-		Timeout:   time.Second * time.Duration(timeout),
-	}
-
 	apiVersion := "v2"
 
 	requestURL := fmt.Sprintf("https://%s/api/%s%s", c.Server, apiVersion, apiEndpoint)
 
-	var request *http.Request
+	var requestBody []byte
 	switch callType {
 	case "GET":
-		request, _ = http.NewRequest(callType, getEscape(requestURL), nil)
-	case "POST":
-		convertedConfig, _ := json.Marshal(config)
-		request, _ = http.NewRequest(callType, requestURL, bytes.NewBuffer(convertedConfig))
-	case "PATCH":
-		convertedConfig, _ := json.Marshal(config)
-		request, _ = http.NewRequest(callType, requestURL, bytes.NewBuffer(convertedConfig))
-	case "DELETE":
-		request, _ = http.NewRequest(callType, requestURL, nil)
+		requestURL = getEscape(requestURL)
+		if rawQuery != "" {
+			requestURL = requestURL + "?" + rawQuery
+		}
+	case "POST", "PATCH":
+		requestBody, _ = json.Marshal(config)
 	}
 
-	request.SetBasicAuth(c.Username, c.Password)
+	retryPolicy := c.retryPolicy(ctx)
 
-	request.Header.Set("Content-Type", "application/json")
+	var apiRequest *httpResult
+	for attempt := 1; ; attempt++ {
+		var err error
+		apiRequest, err = c.sendRequest(ctx, callType, requestURL, requestBody, timeout)
 
-	apiRequest, err := client.Do(request)
-	if err, ok := err.(net.Error); ok && err.Timeout() {
-		return nil, errors.New("Unable to establish a connection to the Silk SDP server")
-	} else if err != nil {
-		return nil, err
+		wait, retry := retryPolicy.shouldRetry(ctx, callType, attempt, apiRequest, err)
+		if retry == false {
+			if err != nil {
+				return nil, connectionError(ctx, err)
+			}
+			break
+		}
+		if err := sleepCtx(ctx, wait); err != nil {
+			return nil, err
+		}
 	}
 
-	// Place a 1 second pause here - Post request but prior to returning the response.
-	duration := time.Second // Pause for 1 second.
-	time.Sleep(duration)
-
-	body, err := ioutil.ReadAll(apiRequest.Body)
-
-	apiResponse := []byte(body)
+	if apiRequest.statusCode >= 400 {
+		return nil, newAPIError(callType, apiEndpoint, apiRequest)
+	}
 
 	var convertedAPIResponse interface{}
-	if err := json.Unmarshal(apiResponse, &convertedAPIResponse); err != nil {
-
-		// DELETE request will return a 204 No Content status
-		if apiRequest.StatusCode == 204 {
-			convertedAPIResponse = map[string]interface{}{}
-			convertedAPIResponse.(map[string]interface{})["statusCode"] = apiRequest.StatusCode
-		} else if apiRequest.StatusCode != 200 {
-			return nil, fmt.Errorf("%s", apiRequest.Status)
+	if len(bytes.TrimSpace(apiRequest.body)) != 0 {
+		// A body that is not JSON is usually an error page returned by a proxy in front of the Silk server
+		if err := json.Unmarshal(apiRequest.body, &convertedAPIResponse); err != nil {
+			apiError := newAPIError(callType, apiEndpoint, apiRequest)
+			apiError.Err = fmt.Errorf("The response body is not valid JSON: %w", err)
+			return nil, apiError
 		}
+	}
 
+	// DELETE request will return a 204 No Content status
+	if convertedAPIResponse == nil {
+		convertedAPIResponse = map[string]interface{}{}
+		convertedAPIResponse.(map[string]interface{})["statusCode"] = apiRequest.statusCode
 	}
 
-	if reflect.TypeOf(convertedAPIResponse).Kind() == reflect.Slice {
+	if reflect.TypeOf(convertedAPIResponse).Kind() != reflect.Map {
 		return convertedAPIResponse, nil
 	}
 
+	// The Silk server may report a failure in the body of an otherwise successful response
 	if _, ok := convertedAPIResponse.(map[string]interface{})["error_msg"]; ok {
-		return nil, fmt.Errorf("%s", convertedAPIResponse.(map[string]interface{})["error_msg"])
+		return nil, newAPIError(callType, apiEndpoint, apiRequest)
 	}
 
 	return convertedAPIResponse, nil
 
 }
 
+// httpResult holds the parts of an HTTP response consumed by makeHTTPCall once the body has been read and closed.
+type httpResult struct {
+	statusCode int
+	status     string
+	header     http.Header
+	body       []byte
+}
+
+// sendRequest issues a single HTTP request and reads the full response body. Transport errors are returned as-is so the
+// retry policy can inspect them, connectionError() turns them into the error handed back to the caller.
+func (c *Credentials) sendRequest(ctx context.Context, callType, requestURL string, requestBody []byte, timeout int) (*httpResult, error) {
+
+	// Waiting for the limiter does not count against the request timeout, only the caller context bounds it
+	release, err := c.limiter.Wait(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	// The timeout applies to the whole exchange, from establishing the connection until the response body has been read
+	requestCtx, cancel := context.WithTimeout(ctx, time.Second*time.Duration(timeout))
+	defer cancel()
+
+	var body io.Reader
+	if requestBody != nil {
+		body = bytes.NewReader(requestBody)
+	}
+
+	request, err := http.NewRequestWithContext(requestCtx, callType, requestURL, body)
+	if err != nil {
+		return nil, err
+	}
+
+	request.SetBasicAuth(c.Username, c.Password)
+
+	request.Header.Set("Content-Type", "application/json")
+
+	apiRequest, err := c.client().Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer apiRequest.Body.Close()
+
+	apiResponse, err := ioutil.ReadAll(apiRequest.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return &httpResult{
+		statusCode: apiRequest.StatusCode,
+		status:     apiRequest.Status,
+		header:     apiRequest.Header,
+		body:       apiResponse,
+	}, nil
+}
+
+// connectionError converts the error of a failed HTTP exchange into the error returned to the caller.
+func connectionError(ctx context.Context, err error) error {
+	if ctx.Err() != nil {
+		// The caller cancelled the request or its deadline passed, report that rather than a connection failure
+		return ctx.Err()
+	}
+	if err, ok := err.(net.Error); ok && err.Timeout() {
+		return errors.New("Unable to establish a connection to the Silk SDP server")
+	}
+	return err
+}
+
 // endpointValidation validates that the endpoint provided in the Base API functions starts with a / but does not end with one except if preceded by a =
 func endpointValidation(apiEndpoint string) string {
 
@@ -252,10 +414,15 @@ func shouldEscape(c byte, mode encoding) bool {
 // The optional timeout value corresponds to the number of seconds to wait to establish a connection to the Silk SDP server before returning a
 // timeout error. If no value is provided, a default of 15 seconds will be used.
 func (c *Credentials) Get(apiEndpoint string, timeout ...int) (interface{}, error) {
+	return c.GetCtx(context.Background(), apiEndpoint, timeout...)
+}
+
+// GetCtx is the context-aware form of Get. The request is abandoned as soon as ctx is cancelled or its deadline passes.
+func (c *Credentials) GetCtx(ctx context.Context, apiEndpoint string, timeout ...int) (interface{}, error) {
 
 	httpTimeout := httpTimeout(timeout)
 
-	apiRequest, err := c.makeHTTPCall("GET", apiEndpoint, nil, httpTimeout)
+	apiRequest, err := c.makeHTTPCall(ctx, "GET", apiEndpoint, "", nil, httpTimeout)
 	if err != nil {
 		return nil, err
 	}
@@ -268,10 +435,15 @@ func (c *Credentials) Get(apiEndpoint string, timeout ...int) (interface{}, erro
 // The optional timeout value corresponds to the number of seconds to wait to establish a connection to the Silk SDP server before returning a
 // timeout error. If no value is provided, a default of 15 seconds will be used.
 func (c *Credentials) Post(apiEndpoint string, config map[string]interface{}, timeout ...int) (interface{}, error) {
+	return c.PostCtx(context.Background(), apiEndpoint, config, timeout...)
+}
+
+// PostCtx is the context-aware form of Post. The request is abandoned as soon as ctx is cancelled or its deadline passes.
+func (c *Credentials) PostCtx(ctx context.Context, apiEndpoint string, config map[string]interface{}, timeout ...int) (interface{}, error) {
 
 	httpTimeout := httpTimeout(timeout)
 
-	apiRequest, err := c.makeHTTPCall("POST", apiEndpoint, config, httpTimeout)
+	apiRequest, err := c.makeHTTPCall(ctx, "POST", apiEndpoint, "", config, httpTimeout)
 	if err != nil {
 		return nil, err
 	}
@@ -284,10 +456,15 @@ func (c *Credentials) Post(apiEndpoint string, config map[string]interface{}, ti
 // The optional timeout value corresponds to the number of seconds to wait to establish a connection to the Silk SDP server before returning a
 // timeout error. If no value is provided, a default of 15 seconds will be used.
 func (c *Credentials) Patch(apiEndpoint string, config interface{}, timeout ...int) (interface{}, error) {
+	return c.PatchCtx(context.Background(), apiEndpoint, config, timeout...)
+}
+
+// PatchCtx is the context-aware form of Patch. The request is abandoned as soon as ctx is cancelled or its deadline passes.
+func (c *Credentials) PatchCtx(ctx context.Context, apiEndpoint string, config interface{}, timeout ...int) (interface{}, error) {
 
 	httpTimeout := httpTimeout(timeout)
 
-	apiRequest, err := c.makeHTTPCall("PATCH", apiEndpoint, config, httpTimeout)
+	apiRequest, err := c.makeHTTPCall(ctx, "PATCH", apiEndpoint, "", config, httpTimeout)
 	if err != nil {
 		return nil, err
 	}
@@ -300,10 +477,15 @@ func (c *Credentials) Patch(apiEndpoint string, config interface{}, timeout ...i
 // The optional timeout value corresponds to the number of seconds to wait to establish a connection to the Silk SDP server before returning a
 // timeout error. If no value is provided, a default of 15 seconds will be used.
 func (c *Credentials) Delete(apiEndpoint string, timeout ...int) (interface{}, error) {
+	return c.DeleteCtx(context.Background(), apiEndpoint, timeout...)
+}
+
+// DeleteCtx is the context-aware form of Delete. The request is abandoned as soon as ctx is cancelled or its deadline passes.
+func (c *Credentials) DeleteCtx(ctx context.Context, apiEndpoint string, timeout ...int) (interface{}, error) {
 
 	httpTimeout := httpTimeout(timeout)
 
-	apiRequest, err := c.makeHTTPCall("DELETE", apiEndpoint, nil, httpTimeout)
+	apiRequest, err := c.makeHTTPCall(ctx, "DELETE", apiEndpoint, "", nil, httpTimeout)
 	if err != nil {
 		return nil, err
 	}
@@ -359,3 +541,6 @@ func (c *Credentials) popStringFromSlice(s []string, e string) []string {
 	}
 	return s
 }
+
+// errNoChanges is returned by the typed update functions when there is nothing to send to the Silk server.
+var errNoChanges = errors.New("The update does not contain any changes")
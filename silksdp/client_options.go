@@ -0,0 +1,312 @@
+package silksdp
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// ClientOption configures the HTTP client used by a Credentials value. Options are consumed by NewClient() and ConnectEnv().
+type ClientOption func(*clientConfig) error
+
+// clientConfig collects the settings provided through ClientOption values before the http.Client is built.
+type clientConfig struct {
+	rootCAs             *x509.CertPool
+	certificates        []tls.Certificate
+	pinnedFingerprints  [][]byte
+	insecureSkipVerify  bool
+	proxy               func(*http.Request) (*url.URL, error)
+	maxIdleConns        int
+	maxIdleConnsPerHost int
+	maxConnsPerHost     int
+	httpClient          *http.Client
+	pollInterval        time.Duration
+	retryPolicy         *RetryPolicy
+	rateLimit           float64
+	rateBurst           int
+	maxInFlight         int
+	limiter             *Limiter
+}
+
+// defaultClientConfig returns the settings used when no ClientOption overrides them. The Silk SDP API is always reached through
+// a single host so the idle pool per host is sized well above the net/http default of 2.
+func defaultClientConfig() *clientConfig {
+	return &clientConfig{
+		proxy:               http.ProxyFromEnvironment,
+		maxIdleConns:        100,
+		maxIdleConnsPerHost: 16,
+		pollInterval:        defaultPollInterval,
+	}
+}
+
+// WithRootCAs verifies the Silk SDP server certificate against the provided certificate pool instead of the system pool.
+func WithRootCAs(pool *x509.CertPool) ClientOption {
+	return func(config *clientConfig) error {
+		if pool == nil {
+			return errors.New("The provided certificate pool is nil")
+		}
+		config.rootCAs = pool
+		return nil
+	}
+}
+
+// WithCABundlePEM adds the PEM encoded CA certificates to the pool used to verify the Silk SDP server certificate. The
+// bundle is added to the system pool, or to the pool provided through WithRootCAs(), so the system CAs remain trusted.
+func WithCABundlePEM(pem []byte) ClientOption {
+	return func(config *clientConfig) error {
+		if config.rootCAs == nil {
+			systemCAs, err := x509.SystemCertPool()
+			if err != nil {
+				systemCAs = x509.NewCertPool()
+			}
+			config.rootCAs = systemCAs
+		}
+		if config.rootCAs.AppendCertsFromPEM(pem) == false {
+			return errors.New("The provided CA bundle does not contain any PEM encoded certificates")
+		}
+		return nil
+	}
+}
+
+// WithCABundle reads a PEM encoded CA bundle from disk and adds it to the pool used to verify the Silk SDP server certificate.
+func WithCABundle(path string) ClientOption {
+	return func(config *clientConfig) error {
+		pem, err := ioutil.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("Unable to read the CA bundle '%s': %v", path, err)
+		}
+		return WithCABundlePEM(pem)(config)
+	}
+}
+
+// WithClientCertificate presents the provided certificate when the Silk SDP server requests mutual TLS authentication.
+func WithClientCertificate(cert tls.Certificate) ClientOption {
+	return func(config *clientConfig) error {
+		config.certificates = append(config.certificates, cert)
+		return nil
+	}
+}
+
+// WithClientCertificateFiles loads a PEM encoded certificate and private key from disk and presents them when the Silk SDP
+// server requests mutual TLS authentication.
+func WithClientCertificateFiles(certFile, keyFile string) ClientOption {
+	return func(config *clientConfig) error {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return fmt.Errorf("Unable to load the client certificate: %v", err)
+		}
+		config.certificates = append(config.certificates, cert)
+		return nil
+	}
+}
+
+// WithPinnedCertificate only trusts a Silk SDP server whose leaf certificate has the provided SHA-256 fingerprint. The
+// fingerprint is hex encoded and may contain ':' separators (ex: AB:CD:...). The option may be repeated to allow several
+// certificates, for example during a certificate rotation.
+//
+// A pinned certificate replaces the CA chain validation which makes it suitable for arrays using a self-signed certificate.
+func WithPinnedCertificate(fingerprint string) ClientOption {
+	return func(config *clientConfig) error {
+		pin, err := hex.DecodeString(strings.Replace(fingerprint, ":", "", -1))
+		if err != nil || len(pin) != sha256.Size {
+			return fmt.Errorf("'%s' is not a valid SHA-256 certificate fingerprint", fingerprint)
+		}
+		config.pinnedFingerprints = append(config.pinnedFingerprints, pin)
+		return nil
+	}
+}
+
+// WithInsecureSkipVerify disables the verification of the Silk SDP server certificate. This should only be used in lab
+// environments; WithPinnedCertificate() is the safer choice for arrays with a self-signed certificate.
+func WithInsecureSkipVerify() ClientOption {
+	return func(config *clientConfig) error {
+		config.insecureSkipVerify = true
+		return nil
+	}
+}
+
+// WithProxy routes requests through the proxy returned by the provided function. By default the proxy is read from the
+// HTTPS_PROXY and NO_PROXY environment variables.
+func WithProxy(proxy func(*http.Request) (*url.URL, error)) ClientOption {
+	return func(config *clientConfig) error {
+		config.proxy = proxy
+		return nil
+	}
+}
+
+// WithProxyURL routes every request through the provided proxy URL (ex: http://proxy.example.com:3128).
+func WithProxyURL(proxyURL string) ClientOption {
+	return func(config *clientConfig) error {
+		parsedURL, err := url.Parse(proxyURL)
+		if err != nil {
+			return fmt.Errorf("'%s' is not a valid proxy URL: %v", proxyURL, err)
+		}
+		config.proxy = http.ProxyURL(parsedURL)
+		return nil
+	}
+}
+
+// WithConnectionPool sizes the connection pool. maxIdleConns and maxIdleConnsPerHost bound the number of keep-alive
+// connections kept open between requests while maxConnsPerHost bounds the total number of connections to the server.
+// A value of 0 for maxConnsPerHost means no limit.
+func WithConnectionPool(maxIdleConns, maxIdleConnsPerHost, maxConnsPerHost int) ClientOption {
+	return func(config *clientConfig) error {
+		if maxIdleConns < 0 || maxIdleConnsPerHost < 0 || maxConnsPerHost < 0 {
+			return errors.New("The connection pool sizes can not be negative")
+		}
+		config.maxIdleConns = maxIdleConns
+		config.maxIdleConnsPerHost = maxIdleConnsPerHost
+		config.maxConnsPerHost = maxConnsPerHost
+		return nil
+	}
+}
+
+// WithHTTPClient uses the provided http.Client for every request. All other transport related options are ignored when
+// this option is present.
+func WithHTTPClient(client *http.Client) ClientOption {
+	return func(config *clientConfig) error {
+		if client == nil {
+			return errors.New("The provided http.Client is nil")
+		}
+		config.httpClient = client
+		return nil
+	}
+}
+
+// WithPollInterval sets the pause between two checks made by WaitFor() and the WaitForX() functions.
+func WithPollInterval(interval time.Duration) ClientOption {
+	return func(config *clientConfig) error {
+		if interval <= 0 {
+			return errors.New("The poll interval must be greater than 0")
+		}
+		config.pollInterval = interval
+		return nil
+	}
+}
+
+// WithRetryPolicy replaces DefaultRetryPolicy() as the policy used to retry failed requests. Use a policy with MaxAttempts
+// set to 1 to disable retries.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(config *clientConfig) error {
+		if policy.MaxAttempts < 1 {
+			return errors.New("The retry policy must allow at least 1 attempt")
+		}
+		config.retryPolicy = &policy
+		return nil
+	}
+}
+
+// WithRateLimit caps the number of requests sent to the Silk SDP server to requestsPerSecond, allowing short bursts of
+// up to burst requests.
+func WithRateLimit(requestsPerSecond float64, burst int) ClientOption {
+	return func(config *clientConfig) error {
+		if requestsPerSecond <= 0 {
+			return errors.New("The rate limit must be greater than 0")
+		}
+		config.rateLimit = requestsPerSecond
+		config.rateBurst = burst
+		return nil
+	}
+}
+
+// WithMaxInFlight caps the number of requests sent to the Silk SDP server at the same time. Additional requests wait for
+// one of the in-flight requests to complete.
+func WithMaxInFlight(maxInFlight int) ClientOption {
+	return func(config *clientConfig) error {
+		if maxInFlight <= 0 {
+			return errors.New("The maximum number of requests in flight must be greater than 0")
+		}
+		config.maxInFlight = maxInFlight
+		return nil
+	}
+}
+
+// WithLimiter shares the provided Limiter with the client so several Credentials values talking to the same array
+// respect a single budget. WithRateLimit() and WithMaxInFlight() are ignored when this option is present.
+func WithLimiter(limiter *Limiter) ClientOption {
+	return func(config *clientConfig) error {
+		if limiter == nil {
+			return errors.New("The provided Limiter is nil")
+		}
+		config.limiter = limiter
+		return nil
+	}
+}
+
+// newClientConfig applies the provided options on top of the default settings.
+func newClientConfig(opts ...ClientOption) (*clientConfig, error) {
+
+	config := defaultClientConfig()
+	for _, opt := range opts {
+		if err := opt(config); err != nil {
+			return nil, err
+		}
+	}
+
+	return config, nil
+}
+
+// newLimiter returns the Limiter described by the configuration or nil when no limit has been requested.
+func (config *clientConfig) newLimiter() *Limiter {
+
+	if config.limiter != nil {
+		return config.limiter
+	}
+	if config.rateLimit == 0 && config.maxInFlight == 0 {
+		return nil
+	}
+
+	// The options already validated the values
+	limiter, _ := NewLimiter(config.rateLimit, config.rateBurst, config.maxInFlight)
+	return limiter
+}
+
+// newHTTPClient builds the long-lived http.Client described by the configuration.
+func (config *clientConfig) newHTTPClient() *http.Client {
+
+	if config.httpClient != nil {
+		return config.httpClient
+	}
+
+	tlsConfig := &tls.Config{
+		RootCAs:            config.rootCAs,
+		Certificates:       config.certificates,
+		InsecureSkipVerify: config.insecureSkipVerify,
+	}
+
+	if len(config.pinnedFingerprints) != 0 {
+		// The pinned fingerprint takes the place of the CA chain validation
+		pins := config.pinnedFingerprints
+		tlsConfig.InsecureSkipVerify = true
+		tlsConfig.VerifyPeerCertificate = func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			if len(rawCerts) == 0 {
+				return errors.New("The Silk SDP server did not present a certificate")
+			}
+			fingerprint := sha256.Sum256(rawCerts[0])
+			for _, pin := range pins {
+				if bytes.Equal(fingerprint[:], pin) {
+					return nil
+				}
+			}
+			return fmt.Errorf("The Silk SDP server certificate fingerprint %X does not match any pinned fingerprint", fingerprint[:])
+		}
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	transport.Proxy = config.proxy
+	transport.MaxIdleConns = config.maxIdleConns
+	transport.MaxIdleConnsPerHost = config.maxIdleConnsPerHost
+	transport.MaxConnsPerHost = config.maxConnsPerHost
+
+	return &http.Client{Transport: transport}
+}
@@ -0,0 +1,152 @@
+package silksdp
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newTestServer starts a TLS server with the provided handler and returns it along with the host:port value expected by
+// the Server field of Credentials.
+func newTestServer(handler http.HandlerFunc) (*httptest.Server, string) {
+	server := httptest.NewTLSServer(handler)
+	return server, strings.TrimPrefix(server.URL, "https://")
+}
+
+// newTestClient starts a test server with the provided handler and returns a client trusting its certificate. The
+// server is closed when the test ends.
+func newTestClient(t *testing.T, handler http.HandlerFunc, opts ...ClientOption) *Credentials {
+	t.Helper()
+
+	server, address := newTestServer(handler)
+	t.Cleanup(server.Close)
+
+	silk, err := NewClient(address, "admin", "password", append([]ClientOption{WithHTTPClient(server.Client())}, opts...)...)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	return silk
+}
+
+func Test_GetCtxCancelled(t *testing.T) {
+	release := make(chan struct{})
+	silk := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	})
+	defer close(release)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	_, err := silk.GetCtx(ctx, "/hosts")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded, got: %v", err)
+	}
+}
+
+func Test_ConnectVerifiesCertificate(t *testing.T) {
+	server, address := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"hits": []}`))
+	})
+	defer server.Close()
+
+	silk := Connect(address, "admin", "password")
+	if _, err := silk.Get("/hosts"); err == nil {
+		t.Errorf("Expected the self-signed test certificate to be rejected")
+	}
+
+	insecure, err := NewClient(address, "admin", "password", WithInsecureSkipVerify())
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	if _, err := insecure.Get("/hosts"); err != nil {
+		t.Errorf("Failed to fetch hosts with certificate verification disabled: %v", err)
+	}
+}
+
+func Test_CABundleKeepsSystemCAs(t *testing.T) {
+	server, address := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"hits": []}`))
+	})
+	defer server.Close()
+
+	bundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	silk, err := NewClient(address, "admin", "password", WithCABundlePEM(bundle))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	if _, err := silk.Get("/hosts"); err != nil {
+		t.Errorf("Failed to fetch hosts with the test certificate in the CA bundle: %v", err)
+	}
+
+	expected := 1
+	if systemCAs, err := x509.SystemCertPool(); err == nil {
+		expected += len(systemCAs.Subjects())
+	}
+	config := defaultClientConfig()
+	if err := WithCABundlePEM(bundle)(config); err != nil {
+		t.Fatalf("Failed to add the CA bundle: %v", err)
+	}
+	if subjects := len(config.rootCAs.Subjects()); subjects != expected {
+		t.Errorf("Expected the CA bundle to be added to the %d system CAs, got %d CAs", expected-1, subjects)
+	}
+}
+
+func Test_PinnedCertificate(t *testing.T) {
+	server, address := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"hits": []}`))
+	})
+	defer server.Close()
+
+	fingerprint := sha256.Sum256(server.Certificate().Raw)
+
+	pinned, err := NewClient(address, "admin", "password", WithPinnedCertificate(hex.EncodeToString(fingerprint[:])))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	if _, err := pinned.Get("/hosts"); err != nil {
+		t.Errorf("Failed to fetch hosts with a matching pinned certificate: %v", err)
+	}
+
+	mismatch, err := NewClient(address, "admin", "password", WithPinnedCertificate(strings.Repeat("00", sha256.Size)))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	if _, err := mismatch.Get("/hosts"); err == nil {
+		t.Errorf("Expected a certificate that does not match the pinned fingerprint to be rejected")
+	}
+
+	if _, err := NewClient(address, "admin", "password", WithPinnedCertificate("not-a-fingerprint")); err == nil {
+		t.Errorf("Expected an invalid fingerprint to be rejected")
+	}
+}
+
+func Test_RetryAfterHonored(t *testing.T) {
+	var calls int
+	silk := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"hits": []}`))
+	})
+
+	if _, err := silk.Get("/hosts"); err != nil {
+		t.Errorf("Failed to fetch hosts after a Retry-After hint: %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("Expected 2 requests, got %d", calls)
+	}
+}
@@ -0,0 +1,101 @@
+package silksdp
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+)
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	durationType = reflect.TypeOf(time.Duration(0))
+)
+
+// decode converts an API Response (map[string]interface{}) into the provided struct. Epoch timestamps returned by the
+// Silk server are decoded into time.Time fields and durations, which the Silk server expresses in seconds, into
+// time.Duration fields. Values of the wrong type are reported as errors, the extra hooks (ex: numericStringHook) relax
+// that for the few responses that need it.
+func decode(input interface{}, output interface{}, hooks ...mapstructure.DecodeHookFuncType) error {
+
+	composed := []mapstructure.DecodeHookFunc{epochToTimeHook, secondsToDurationHook}
+	for _, hook := range hooks {
+		composed = append(composed, hook)
+	}
+
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook: mapstructure.ComposeDecodeHookFunc(composed...),
+		Result:     output,
+	})
+	if err != nil {
+		return err
+	}
+
+	return decoder.Decode(input)
+}
+
+// epochToTimeHook converts the seconds since the Unix epoch used by the Silk server into a time.Time. A timestamp of
+// 0 is decoded as the zero time.Time.
+func epochToTimeHook(from reflect.Type, to reflect.Type, data interface{}) (interface{}, error) {
+	if to != timeType {
+		return data, nil
+	}
+
+	var seconds float64
+	switch value := data.(type) {
+	case float64:
+		seconds = value
+	case int:
+		seconds = float64(value)
+	case int64:
+		seconds = float64(value)
+	default:
+		return data, nil
+	}
+
+	if seconds == 0 {
+		return time.Time{}, nil
+	}
+
+	whole, fraction := math.Modf(seconds)
+	return time.Unix(int64(whole), int64(fraction*float64(time.Second))), nil
+}
+
+// secondsToDurationHook converts the number of seconds used by the Silk server for durations (ex: the RPO of a
+// Replication Session) into a time.Duration.
+func secondsToDurationHook(from reflect.Type, to reflect.Type, data interface{}) (interface{}, error) {
+	if to != durationType {
+		return data, nil
+	}
+
+	switch value := data.(type) {
+	case float64:
+		return time.Duration(value * float64(time.Second)), nil
+	case int:
+		return time.Duration(value) * time.Second, nil
+	case int64:
+		return time.Duration(value) * time.Second, nil
+	}
+
+	return data, nil
+}
+
+// numericStringHook decodes a string holding an integer (ex: "7") into an int field. It is used for the responses that
+// echo values the SDK sends as strings, such as the ones of CreateRetentionPolicy(). Other strings are left to fail.
+func numericStringHook(from reflect.Type, to reflect.Type, data interface{}) (interface{}, error) {
+	value, ok := data.(string)
+	if ok == false || to.Kind() != reflect.Int {
+		return data, nil
+	}
+
+	number, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil {
+		return nil, fmt.Errorf("'%s' is not a valid integer", value)
+	}
+
+	return number, nil
+}
@@ -0,0 +1,67 @@
+package silksdp
+
+import (
+	"testing"
+	"time"
+)
+
+func Test_DecodeVolumeGroup(t *testing.T) {
+	input := map[string]interface{}{
+		"id":                 float64(12),
+		"name":               "vg01",
+		"creation_time":      float64(1600000000),
+		"description":        nil,
+		"quota":              nil,
+		"capacity_policy":    map[string]interface{}{"ref": "/vg_capacity_policies/3"},
+		"last_restored_from": nil,
+		"last_restored_time": float64(1600000123.5),
+		"logical_capacity":   float64(10485760),
+	}
+
+	var volumeGroup CreateOrUpdateVolumeGroupResponse
+	if err := decode(input, &volumeGroup); err != nil {
+		t.Fatalf("Failed to decode the volume group: %v", err)
+	}
+
+	if volumeGroup.CreationTime.Equal(time.Unix(1600000000, 0)) == false {
+		t.Errorf("Unexpected creation time: %s", volumeGroup.CreationTime)
+	}
+	if volumeGroup.LastRestoredTime == nil || volumeGroup.LastRestoredTime.Equal(time.Unix(1600000123, 500000000)) == false {
+		t.Errorf("Unexpected last restored time: %v", volumeGroup.LastRestoredTime)
+	}
+	if volumeGroup.Description != nil || volumeGroup.Quota != 0 || volumeGroup.LastRestoredFrom.IsZero() == false {
+		t.Errorf("Expected the null fields to be unset, got %#v", volumeGroup)
+	}
+	if volumeGroup.CapacityPolicy != CapacityPolicyRef(3) || volumeGroup.LogicalCapacity != GiB(10) {
+		t.Errorf("Unexpected capacity policy %s or logical capacity %s", volumeGroup.CapacityPolicy, volumeGroup.LogicalCapacity)
+	}
+}
+
+func Test_DecodeRetentionPolicy(t *testing.T) {
+	// CreateRetentionPolicy() sends its values as strings, which the Silk server may echo back
+	var retentionPolicy CreateOrUpdateRetentionPolicyResponse
+	if err := decode(map[string]interface{}{"name": "daily", "num_snapshots": "7", "days": float64(7)}, &retentionPolicy, numericStringHook); err != nil {
+		t.Fatalf("Failed to decode the retention policy: %v", err)
+	}
+	if retentionPolicy.NumSnapshots != 7 || retentionPolicy.Days != 7 {
+		t.Errorf("Unexpected retention policy: %#v", retentionPolicy)
+	}
+
+	if err := decode(map[string]interface{}{"num_snapshots": "seven"}, &retentionPolicy, numericStringHook); err == nil {
+		t.Errorf("Expected a non numeric string to be refused")
+	}
+}
+
+func Test_DecodeRejectsWrongTypes(t *testing.T) {
+	inputs := []map[string]interface{}{
+		{"id": "12"},
+		{"is_dedup": "true"},
+		{"name": []interface{}{}},
+	}
+	for _, input := range inputs {
+		var volume Volume
+		if err := decode(input, &volume); err == nil {
+			t.Errorf("Expected %v to be refused, got %#v", input, volume)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package silksdp
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// APIError is returned when the Silk SDP server rejects a request. It can be inspected with errors.As() or with the
+// IsNotFound(), IsConflict(), IsUnauthorized(), IsForbidden(), and IsBadRequest() helpers.
+type APIError struct {
+	// Method is the HTTP method of the failed request (ex: GET)
+	Method string
+	// Endpoint is the API endpoint of the failed request (ex: /hosts/12)
+	Endpoint string
+	// StatusCode is the HTTP status code returned by the server
+	StatusCode int
+	// Status is the HTTP status line returned by the server (ex: 404 Not Found)
+	Status string
+	// Message is the error_msg value of the Silk error payload, if any
+	Message string
+	// Code is the error_code value of the Silk error payload, if any
+	Code string
+	// Body is the raw response body
+	Body []byte
+	// Err is the error met while decoding the body of an otherwise successful response, if any
+	Err error
+}
+
+// Error implements the error interface.
+func (e *APIError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("%s %s: %s: %v", e.Method, e.Endpoint, e.Status, e.Err)
+	}
+	if e.Message != "" {
+		return fmt.Sprintf("%s %s: %s: %s", e.Method, e.Endpoint, e.Status, e.Message)
+	}
+	return fmt.Sprintf("%s %s: %s", e.Method, e.Endpoint, e.Status)
+}
+
+// Unwrap returns the decoding error, if any, so that it can be inspected with errors.As().
+func (e *APIError) Unwrap() error {
+	return e.Err
+}
+
+// newAPIError builds an APIError from the response of a failed request, decoding the Silk error payload when present.
+func newAPIError(callType, apiEndpoint string, apiRequest *httpResult) *APIError {
+
+	apiError := &APIError{
+		Method:     callType,
+		Endpoint:   apiEndpoint,
+		StatusCode: apiRequest.statusCode,
+		Status:     apiRequest.status,
+		Body:       apiRequest.body,
+	}
+
+	var payload map[string]interface{}
+	if err := json.Unmarshal(apiRequest.body, &payload); err == nil {
+		if message, ok := payload["error_msg"]; ok && message != nil {
+			apiError.Message = fmt.Sprint(message)
+		}
+		if code, ok := payload["error_code"]; ok && code != nil {
+			apiError.Code = fmt.Sprint(code)
+		}
+	}
+
+	return apiError
+}
+
+// ObjectKind identifies the type of a Silk object (ex: Host, Volume Group).
+type ObjectKind string
+
+// Object kinds reported by NotFoundError.
+const (
+	KindHost                ObjectKind = "Host"
+	KindHostGroup           ObjectKind = "Host Group"
+	KindVolume              ObjectKind = "Volume"
+	KindVolumeGroup         ObjectKind = "Volume Group"
+	KindVolumeGroupSnapshot ObjectKind = "Volume Group Snapshot"
+	KindCapacityPolicy      ObjectKind = "Capacity Policy"
+	KindRetentionPolicy     ObjectKind = "Retention Policy"
+	KindQoSPolicy           ObjectKind = "QoS Policy"
+	KindReplicationPeer     ObjectKind = "Replication Peer Array"
+	KindReplicationSession  ObjectKind = "Replication Session"
+)
+
+// NotFoundError is returned when a lookup by name or by ID does not match any object on the Silk server. Exactly one of
+// Name and ID is set, depending on how the object was looked up.
+type NotFoundError struct {
+	Kind ObjectKind
+	Name string
+	ID   int
+}
+
+// Error implements the error interface.
+func (e *NotFoundError) Error() string {
+	if e.Name != "" {
+		return fmt.Sprintf("The server does not contain a %s named '%s'", e.Kind, e.Name)
+	}
+	return fmt.Sprintf("The server does not contain a %s with the ID of '%d'", e.Kind, e.ID)
+}
+
+// hasStatusCode reports whether err is, or wraps, an APIError with the provided HTTP status code.
+func hasStatusCode(err error, statusCode int) bool {
+	var apiError *APIError
+	if errors.As(err, &apiError) {
+		return apiError.StatusCode == statusCode
+	}
+	return false
+}
+
+// IsNotFound reports whether err is a NotFoundError or was caused by the Silk server answering 404 Not Found.
+func IsNotFound(err error) bool {
+	var notFoundError *NotFoundError
+	if errors.As(err, &notFoundError) {
+		return true
+	}
+	return hasStatusCode(err, http.StatusNotFound)
+}
+
+// IsConflict reports whether err was caused by the Silk server answering 409 Conflict (ex: an object with the same name
+// already exists).
+func IsConflict(err error) bool {
+	return hasStatusCode(err, http.StatusConflict)
+}
+
+// IsUnauthorized reports whether err was caused by the Silk server answering 401 Unauthorized, which usually means the
+// username or password is wrong.
+func IsUnauthorized(err error) bool {
+	return hasStatusCode(err, http.StatusUnauthorized)
+}
+
+// IsForbidden reports whether err was caused by the Silk server answering 403 Forbidden.
+func IsForbidden(err error) bool {
+	return hasStatusCode(err, http.StatusForbidden)
+}
+
+// IsBadRequest reports whether err was caused by the Silk server answering 400 Bad Request, typically a validation failure.
+func IsBadRequest(err error) bool {
+	return hasStatusCode(err, http.StatusBadRequest)
+}
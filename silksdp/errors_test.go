@@ -0,0 +1,100 @@
+package silksdp
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func Test_APIError(t *testing.T) {
+	silk := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/hosts/12":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error_msg": "Object not found", "error_code": 1203}`))
+		case "/api/v2/hosts":
+			w.WriteHeader(http.StatusConflict)
+			w.Write([]byte(`{"error_msg": "Name already in use"}`))
+		default:
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	})
+
+	_, err := silk.Get("/hosts/12")
+	var apiError *APIError
+	if !errors.As(err, &apiError) {
+		t.Fatalf("Expected an *APIError, got: %v", err)
+	}
+	if apiError.Method != "GET" || apiError.Endpoint != "/hosts/12" || apiError.StatusCode != 404 {
+		t.Errorf("Unexpected request details: %+v", apiError)
+	}
+	if apiError.Message != "Object not found" || apiError.Code != "1203" {
+		t.Errorf("Unexpected Silk error payload: %+v", apiError)
+	}
+	if !IsNotFound(err) || IsConflict(err) {
+		t.Errorf("Expected only IsNotFound to match: %v", err)
+	}
+
+	_, err = silk.Post("/hosts", map[string]interface{}{"name": "host01"})
+	if !IsConflict(err) {
+		t.Errorf("Expected IsConflict to match: %v", err)
+	}
+
+	_, err = silk.Get("/system/state")
+	if !IsUnauthorized(err) {
+		t.Errorf("Expected IsUnauthorized to match: %v", err)
+	}
+}
+
+func Test_APIErrorInvalidJSON(t *testing.T) {
+	silk := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/hosts":
+			w.Write([]byte(`<html>Bad Gateway</html>`))
+		case "/api/v2/host_groups":
+			w.WriteHeader(http.StatusCreated)
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL)
+		}
+	})
+
+	_, err := silk.Get("/hosts")
+	var apiError *APIError
+	var syntaxError *json.SyntaxError
+	if !errors.As(err, &apiError) || !errors.As(err, &syntaxError) {
+		t.Fatalf("Expected an *APIError wrapping a *json.SyntaxError, got: %v", err)
+	}
+	if apiError.StatusCode != 200 || string(apiError.Body) != "<html>Bad Gateway</html>" {
+		t.Errorf("Unexpected request details: %+v", apiError)
+	}
+
+	response, err := silk.Post("/host_groups", map[string]interface{}{"name": "hg01"})
+	if err != nil {
+		t.Fatalf("Expected an empty body to be accepted, got: %v", err)
+	}
+	if response.(map[string]interface{})["statusCode"] != 201 {
+		t.Errorf("Expected the status code of the empty response, got %v", response)
+	}
+}
+
+func Test_NotFoundError(t *testing.T) {
+	silk := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"hits": [{"id": 1, "name": "host01"}], "limit": 100, "offset": 0, "total": 1}`))
+	})
+
+	_, err := silk.GetHostID("host02")
+	var notFoundError *NotFoundError
+	if !errors.As(err, &notFoundError) {
+		t.Fatalf("Expected a *NotFoundError, got: %v", err)
+	}
+	if notFoundError.Kind != KindHost || notFoundError.Name != "host02" {
+		t.Errorf("Unexpected lookup details: %+v", notFoundError)
+	}
+	if !IsNotFound(err) {
+		t.Errorf("Expected IsNotFound to match: %v", err)
+	}
+	if err.Error() != "The server does not contain a Host named 'host02'" {
+		t.Errorf("Unexpected error message: %v", err)
+	}
+}
@@ -1,8 +1,10 @@
 package silksdp_test
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/silk-us/silk-sdp-go-sdk/silksdp"
 )
@@ -799,3 +801,28 @@ func ExampleCredentials_GetCapacityPolicyName() {
 	fmt.Println(getPolicyName)
 
 }
+
+func ExampleCredentials_DeleteHostCtx() {
+
+	// Use ConnectEnv to look up the Silk Server, Username, and Password
+	// using environment variables
+	silk, err := silksdp.ConnectEnv()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Every request issued while removing the Host's mappings, IQNs, PWWNs and the
+	// Host itself is abandoned once the deadline passes
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	hostName := "ExampleHostName"
+
+	deleteHost, err := silk.DeleteHostCtx(ctx, hostName)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println(deleteHost)
+
+}
@@ -1,16 +1,19 @@
 package silksdp
 
 import (
+	"context"
 	"fmt"
-	"strings"
-	"strconv"
-	"github.com/mitchellh/mapstructure"
 )
 
 // CreateHost creates a new Host on the Silk server.
 //
 // Valid hostType choices are 'Linux', 'Windows', and 'ESX'.
 func (c *Credentials) CreateHost(name, hostType string, timeout ...int) (*CreateOrUpdateHostResponse, error) {
+	return c.CreateHostCtx(context.Background(), name, hostType, timeout...)
+}
+
+// CreateHostCtx is the context-aware form of CreateHost.
+func (c *Credentials) CreateHostCtx(ctx context.Context, name, hostType string, timeout ...int) (*CreateOrUpdateHostResponse, error) {
 
 	httpTimeout := httpTimeout(timeout)
 
@@ -25,14 +28,14 @@ func (c *Credentials) CreateHost(name, hostType string, timeout ...int) (*Create
 	config["name"] = name
 	config["type"] = hostType
 
-	apiRequest, err := c.Post("/hosts", config, httpTimeout)
+	apiRequest, err := c.PostCtx(ctx, "/hosts", config, httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 
 	// Convert the API Response (map[string]interface{}) to a struct
 	var apiResponse CreateOrUpdateHostResponse
-	mapErr := mapstructure.Decode(apiRequest, &apiResponse)
+	mapErr := decode(apiRequest, &apiResponse)
 	if mapErr != nil {
 		return nil, mapErr
 	}
@@ -42,17 +45,46 @@ func (c *Credentials) CreateHost(name, hostType string, timeout ...int) (*Create
 
 // GetHosts returns information on all Hosts found on the Silk server.
 func (c *Credentials) GetHosts(timeout ...int) (*GetHostsResponse, error) {
+	return c.GetHostsCtx(context.Background(), timeout...)
+}
+
+// GetHostsCtx is the context-aware form of GetHosts.
+func (c *Credentials) GetHostsCtx(ctx context.Context, timeout ...int) (*GetHostsResponse, error) {
 
 	httpTimeout := httpTimeout(timeout)
 
-	apiRequest, err := c.Get("/hosts", httpTimeout)
+	apiRequest, err := c.getAllCtx(ctx, "/hosts", nil, httpTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert the API Response (map[string]interface{}) to a struct
+	var apiResponse GetHostsResponse
+	mapErr := decode(apiRequest, &apiResponse)
+	if mapErr != nil {
+		return nil, mapErr
+	}
+
+	return &apiResponse, nil
+}
+
+// FindHosts returns the Hosts matching the provided filter, fetching every page of the
+// results (ex: silksdp.Filter().Name().Contains("db")).
+func (c *Credentials) FindHosts(filter ListFilter, timeout ...int) (*GetHostsResponse, error) {
+	return c.FindHostsCtx(context.Background(), filter, timeout...)
+}
+
+// FindHostsCtx is the context-aware form of FindHosts.
+func (c *Credentials) FindHostsCtx(ctx context.Context, filter ListFilter, timeout ...int) (*GetHostsResponse, error) {
+
+	apiRequest, err := c.getAllCtx(ctx, "/hosts", filter, httpTimeout(timeout))
 	if err != nil {
 		return nil, err
 	}
 
 	// Convert the API Response (map[string]interface{}) to a struct
 	var apiResponse GetHostsResponse
-	mapErr := mapstructure.Decode(apiRequest, &apiResponse)
+	mapErr := decode(apiRequest, &apiResponse)
 	if mapErr != nil {
 		return nil, mapErr
 	}
@@ -60,18 +92,23 @@ func (c *Credentials) GetHosts(timeout ...int) (*GetHostsResponse, error) {
 	return &apiResponse, nil
 }
 
-// Returns information about single host
+// GetHost returns information about a single Host.
 func (c *Credentials) GetHost(hostname string, timeout ...int) (*GetHostsResponse, error) {
+	return c.GetHostCtx(context.Background(), hostname, timeout...)
+}
+
+// GetHostCtx is the context-aware form of GetHost.
+func (c *Credentials) GetHostCtx(ctx context.Context, hostname string, timeout ...int) (*GetHostsResponse, error) {
 
 	httpTimeout := httpTimeout(timeout)
-	apiRequest, err := c.Get(fmt.Sprintf("/hosts?name__in=%v", hostname), httpTimeout)
+	apiRequest, err := c.getAllCtx(ctx, "/hosts", Filter().Name().In(hostname), httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 
 	// Convert the API Response (map[string]interface{}) to a struct
 	var apiResponse GetHostsResponse
-	mapErr := mapstructure.Decode(apiRequest, &apiResponse)
+	mapErr := decode(apiRequest, &apiResponse)
 	if mapErr != nil {
 		return nil, mapErr
 	}
@@ -79,17 +116,37 @@ func (c *Credentials) GetHost(hostname string, timeout ...int) (*GetHostsRespons
 	return &apiResponse, nil
 }
 
+// HostUpdate holds the changes applied to a Host by ApplyHostUpdate(). Only the fields that are set are sent to the
+// Silk server.
+type HostUpdate struct {
+	// Name renames the Host.
+	Name *string
+	// Type changes the Host type. Valid choices are 'Linux', 'Windows', and 'ESX'.
+	Type *string
+	// HostGroup moves the Host to the Host Group with the provided name. An empty name removes the Host from its
+	// Host Group.
+	HostGroup *string
+}
+
 // UpdateHost updates the Host with the provided config options.
 //
-// Valid keys for the config map[string]interface{} are: name, type. and host_group.
+// Valid keys for the config map[string]interface{} are: name, type, and host_group.
+//
+// Deprecated: Use ApplyHostUpdate() and a HostUpdate, which are validated at compile time.
 func (c *Credentials) UpdateHost(name string, config map[string]interface{}, timeout ...int) (*CreateOrUpdateHostResponse, error) {
+	return c.UpdateHostCtx(context.Background(), name, config, timeout...)
+}
+
+// UpdateHostCtx is the context-aware form of UpdateHost.
+//
+// Deprecated: Use ApplyHostUpdateCtx() and a HostUpdate, which are validated at compile time.
+func (c *Credentials) UpdateHostCtx(ctx context.Context, name string, config map[string]interface{}, timeout ...int) (*CreateOrUpdateHostResponse, error) {
 	httpTimeout := httpTimeout(timeout)
 
 	// Validate that the user provided keys are valid for this API
 	validUpdateKeys := []string{"name", "type", "host_group"}
 	var invalidUserProvidedKeys []string
 	for key := range config {
-
 		if c.stringInSlice(validUpdateKeys, key) == false {
 			invalidUserProvidedKeys = append(invalidUserProvidedKeys, key)
 		}
@@ -100,19 +157,66 @@ func (c *Credentials) UpdateHost(name string, config map[string]interface{}, tim
 		return nil, fmt.Errorf("The provided 'config' parameter contains invalid keys. 'name' and 'type' are the only valid choices")
 	}
 
-	hostID, err := c.GetHostID(name)
+	return c.updateHost(ctx, name, config, httpTimeout)
+}
+
+// ApplyHostUpdate applies the provided changes to a Host. Only the fields of the update that are set are sent to the
+// Silk server, and an error is returned if none are.
+func (c *Credentials) ApplyHostUpdate(name string, update HostUpdate, timeout ...int) (*CreateOrUpdateHostResponse, error) {
+	return c.ApplyHostUpdateCtx(context.Background(), name, update, timeout...)
+}
+
+// ApplyHostUpdateCtx is the context-aware form of ApplyHostUpdate.
+func (c *Credentials) ApplyHostUpdateCtx(ctx context.Context, name string, update HostUpdate, timeout ...int) (*CreateOrUpdateHostResponse, error) {
+
+	httpTimeout := httpTimeout(timeout)
+
+	config := map[string]interface{}{}
+	if update.Name != nil {
+		config["name"] = *update.Name
+	}
+	if update.Type != nil {
+		validHostTypes := []string{"Linux", "Windows", "ESX"}
+		if c.stringInSlice(validHostTypes, *update.Type) == false {
+			return nil, fmt.Errorf("'%s' is not a valid hostType. Valid choices are 'Linux', 'Windows', and 'ESX'", *update.Type)
+		}
+		config["type"] = *update.Type
+	}
+	if update.HostGroup != nil {
+		if *update.HostGroup == "" {
+			config["host_group"] = map[string]interface{}{}
+		} else {
+			hostGroupID, err := c.GetHostGroupIDCtx(ctx, *update.HostGroup, httpTimeout)
+			if err != nil {
+				return nil, err
+			}
+			config["host_group"] = HostGroupRef(hostGroupID)
+		}
+	}
+
+	if len(config) == 0 {
+		return nil, errNoChanges
+	}
+
+	return c.updateHost(ctx, name, config, httpTimeout)
+}
+
+// updateHost sends the PATCH request shared by UpdateHostCtx() and ApplyHostUpdateCtx().
+func (c *Credentials) updateHost(ctx context.Context, name string, config map[string]interface{}, httpTimeout int) (*CreateOrUpdateHostResponse, error) {
+
+	hostID, err := c.GetHostIDCtx(ctx, name, httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 
-	apiRequest, err := c.Patch(fmt.Sprintf("/hosts/%d", hostID), config, httpTimeout)
+	apiRequest, err := c.PatchCtx(ctx, fmt.Sprintf("/hosts/%d", hostID), config, httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 
 	// Convert the API Response (map[string]interface{}) to a struct
 	var apiResponse CreateOrUpdateHostResponse
-	mapErr := mapstructure.Decode(apiRequest, &apiResponse)
+	mapErr := decode(apiRequest, &apiResponse)
 	if mapErr != nil {
 		return nil, mapErr
 	}
@@ -123,56 +227,60 @@ func (c *Credentials) UpdateHost(name string, config map[string]interface{}, tim
 
 // DeleteHost deletes a Host from the Silk server.
 func (c *Credentials) DeleteHost(name string, timeout ...int) (*DeleteResponse, error) {
+	return c.DeleteHostCtx(context.Background(), name, timeout...)
+}
+
+// DeleteHostCtx is the context-aware form of DeleteHost.
+func (c *Credentials) DeleteHostCtx(ctx context.Context, name string, timeout ...int) (*DeleteResponse, error) {
 
 	httpTimeout := httpTimeout(timeout)
 
-	host, err := c.GetHost(name,httpTimeout)
+	host, err := c.GetHostCtx(ctx, name, httpTimeout)
 	if err != nil {
-		return nil,fmt.Errorf("")
+		return nil, err
+	}
+	if len(host.Hits) == 0 {
+		return nil, &NotFoundError{Kind: KindHost, Name: name}
 	}
 	hostID := host.Hits[0].ID
 	if host.Hits[0].IsPartOfGroup {
-		hostGroupRef := host.Hits[0].HostGroup.Ref
-		hostGroupRefSplit := strings.Split(hostGroupRef,"/")
-		hostGroupID, err := strconv.Atoi(hostGroupRefSplit[len(hostGroupRefSplit)-1])
-		if err != nil{
-			return nil,fmt.Errorf("Invalid hostgroup ID")
+		hostGroupID := host.Hits[0].HostGroup.ID()
+		if host.Hits[0].HostGroup.Kind() != KindHostGroup {
+			return nil, fmt.Errorf("Invalid hostgroup reference '%s'", host.Hits[0].HostGroup)
+		}
+		hostGroupName, err := c.GetHostGroupNameCtx(ctx, hostGroupID)
+		if err != nil {
+			return nil, fmt.Errorf("Could not find hostgroup with ID=%d: %w", hostGroupID, err)
 		}
-		hostGroupName, err := c.GetHostGroupName(hostGroupID)
-		if err != nil{
-			return nil,fmt.Errorf("Could not find hostgroup with ID=%d",hostGroupID) 
+		_, err = c.DeleteHostHostGroupMappingCtx(ctx, name, hostGroupName)
+		if err != nil {
+			return nil, err
 		}
-		_,err = c.DeleteHostHostGroupMapping(name,hostGroupName)
-		if err != nil{
-			return nil,err
-		} 
 	}
 
-
-
-	_, err = c.DeleteHostMappings(name,httpTimeout)
+	_, err = c.DeleteHostMappingsCtx(ctx, name, httpTimeout)
 	if err != nil {
-		return nil,fmt.Errorf("Failed to remove host mappings for %v",name)
+		return nil, fmt.Errorf("Failed to remove host mappings for %v: %w", name, err)
 	}
 
-	_, err = c.DeleteHostIQN(name, httpTimeout)
+	_, err = c.DeleteHostIQNCtx(ctx, name, httpTimeout)
 	if err != nil {
-		return nil,fmt.Errorf("Failed to remove host IQN for %v",name)
+		return nil, fmt.Errorf("Failed to remove host IQN for %v: %w", name, err)
 	}
 
-	_, err = c.DeleteHostPWWN(name, httpTimeout)
+	_, err = c.DeleteHostPWWNCtx(ctx, name, httpTimeout)
 	if err != nil {
-		return nil,fmt.Errorf("Failed to remove host PWWN's for %v",name)
+		return nil, fmt.Errorf("Failed to remove host PWWN's for %v: %w", name, err)
 	}
 
-	apiRequest, err := c.Delete(fmt.Sprintf("/hosts/%d", hostID), httpTimeout)
+	apiRequest, err := c.DeleteCtx(ctx, fmt.Sprintf("/hosts/%d", hostID), httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 
 	// Convert the API Response (map[string]interface{}) to a struct
 	var apiResponse DeleteResponse
-	mapErr := mapstructure.Decode(apiRequest, &apiResponse)
+	mapErr := decode(apiRequest, &apiResponse)
 	if mapErr != nil {
 		return nil, mapErr
 	}
@@ -183,30 +291,30 @@ func (c *Credentials) DeleteHost(name string, timeout ...int) (*DeleteResponse,
 
 // CreateHostVolumeMapping will map a Host to the provided Volume.
 func (c *Credentials) CreateHostVolumeMapping(hostName, volumeName string, timeout ...int) (*CreateHostVolumeMappingResponse, error) {
+	return c.CreateHostVolumeMappingCtx(context.Background(), hostName, volumeName, timeout...)
+}
+
+// CreateHostVolumeMappingCtx is the context-aware form of CreateHostVolumeMapping.
+func (c *Credentials) CreateHostVolumeMappingCtx(ctx context.Context, hostName, volumeName string, timeout ...int) (*CreateHostVolumeMappingResponse, error) {
 
 	httpTimeout := httpTimeout(timeout)
 
-	allHosts, err := c.GetHosts(httpTimeout)
+	host, err := c.GetHostCtx(ctx, hostName, httpTimeout)
 	if err != nil {
 		return nil, err
 	}
+	if len(host.Hits) == 0 {
+		return nil, &NotFoundError{Kind: KindHost, Name: hostName}
+	}
 
 	// Validates that the provided host is not part of a Host Group which would prevent the host being added.
-	for _, host := range allHosts.Hits {
-		if host.Name == hostName {
-			if host.IsPartOfGroup == true {
-				return nil, fmt.Errorf("Host '%s' is a member of a Host Group and can not individually be mapped to a volume", hostName)
-			}
-		}
-
+	if host.Hits[0].IsPartOfGroup == true {
+		return nil, fmt.Errorf("Host '%s' is a member of a Host Group and can not individually be mapped to a volume", hostName)
 	}
 
-	hostID, err := c.GetHostID(hostName)
-	if err != nil {
-		return nil, err
-	}
+	hostID := host.Hits[0].ID
 
-	volumeID, err := c.GetVolumeID(volumeName)
+	volumeID, err := c.GetVolumeIDCtx(ctx, volumeName)
 	if err != nil {
 		return nil, err
 	}
@@ -221,14 +329,14 @@ func (c *Credentials) CreateHostVolumeMapping(hostName, volumeName string, timeo
 	config["host"] = hostConfig
 	config["volume"] = volumeConfig
 
-	apiRequest, err := c.Post("/mappings", config, httpTimeout)
+	apiRequest, err := c.PostCtx(ctx, "/mappings", config, httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 
 	// Convert the API Response (map[string]interface{}) to a struct
 	var apiResponse CreateHostVolumeMappingResponse
-	mapErr := mapstructure.Decode(apiRequest, &apiResponse)
+	mapErr := decode(apiRequest, &apiResponse)
 	if mapErr != nil {
 		return nil, mapErr
 	}
@@ -239,10 +347,15 @@ func (c *Credentials) CreateHostVolumeMapping(hostName, volumeName string, timeo
 
 // CreateHostVolumeGroupMapping will map all Volumes in a Volume Group to a Host.
 func (c *Credentials) CreateHostVolumeGroupMapping(hostName, volumeGroupName string, timeout ...int) ([]string, error) {
+	return c.CreateHostVolumeGroupMappingCtx(context.Background(), hostName, volumeGroupName, timeout...)
+}
+
+// CreateHostVolumeGroupMappingCtx is the context-aware form of CreateHostVolumeGroupMapping.
+func (c *Credentials) CreateHostVolumeGroupMappingCtx(ctx context.Context, hostName, volumeGroupName string, timeout ...int) ([]string, error) {
 
 	httpTimeout := httpTimeout(timeout)
 
-	hostID, err := c.GetHostID(hostName)
+	hostID, err := c.GetHostIDCtx(ctx, hostName)
 	if err != nil {
 		return nil, err
 	}
@@ -250,13 +363,13 @@ func (c *Credentials) CreateHostVolumeGroupMapping(hostName, volumeGroupName str
 	hostConfig := map[string]interface{}{}
 	hostConfig["ref"] = fmt.Sprintf("/hosts/%d", hostID)
 
-	volumesInVolumeGroup, err := c.GetVolumeGroupVolumes(volumeGroupName)
+	volumesInVolumeGroup, err := c.GetVolumeGroupVolumesCtx(ctx, volumeGroupName)
 	if err != nil {
 		return nil, err
 	}
 
 	for _, volume := range volumesInVolumeGroup {
-		volumeID, err := c.GetVolumeID(volume)
+		volumeID, err := c.GetVolumeIDCtx(ctx, volume)
 		if err != nil {
 			return nil, err
 		}
@@ -267,14 +380,14 @@ func (c *Credentials) CreateHostVolumeGroupMapping(hostName, volumeGroupName str
 		config["host"] = hostConfig
 		config["volume"] = volumeConfig
 
-		_, err = c.Post("/mappings", config, httpTimeout)
+		_, err = c.PostCtx(ctx, "/mappings", config, httpTimeout)
 		if err != nil {
 			return nil, err
 		}
 
 	}
 
-	volumeHostMappings, err := c.GetVolumeGroupHostGroupMappings(volumeGroupName)
+	volumeHostMappings, err := c.GetVolumeGroupHostGroupMappingsCtx(ctx, volumeGroupName)
 	if err != nil {
 		return nil, err
 	}
@@ -288,17 +401,22 @@ func (c *Credentials) CreateHostVolumeGroupMapping(hostName, volumeGroupName str
 // The returned []HostMappingRespons slice only contains information on the hosts and not
 // the full response of the API call. If no host mappings are found, an empty slice will be returned.
 func (c *Credentials) GetHostMappings(timeout ...int) ([]IndividualHostMappingResponse, error) {
+	return c.GetHostMappingsCtx(context.Background(), timeout...)
+}
+
+// GetHostMappingsCtx is the context-aware form of GetHostMappings.
+func (c *Credentials) GetHostMappingsCtx(ctx context.Context, timeout ...int) ([]IndividualHostMappingResponse, error) {
 
 	httpTimeout := httpTimeout(timeout)
 
-	apiRequest, err := c.Get("/mappings", httpTimeout)
+	apiRequest, err := c.getAllCtx(ctx, "/mappings", nil, httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 
 	// Convert the API Response (map[string]interface{}) to a struct
 	var apiResponse GetHostMappingsResponse
-	mapErr := mapstructure.Decode(apiRequest, &apiResponse)
+	mapErr := decode(apiRequest, &apiResponse)
 	if mapErr != nil {
 		return nil, mapErr
 	}
@@ -318,15 +436,20 @@ func (c *Credentials) GetHostMappings(timeout ...int) ([]IndividualHostMappingRe
 
 // DeleteHostMappings removes all mappings from the provided host.
 func (c *Credentials) DeleteHostMappings(hostName string, timeout ...int) (*DeleteResponse, error) {
+	return c.DeleteHostMappingsCtx(context.Background(), hostName, timeout...)
+}
+
+// DeleteHostMappingsCtx is the context-aware form of DeleteHostMappings.
+func (c *Credentials) DeleteHostMappingsCtx(ctx context.Context, hostName string, timeout ...int) (*DeleteResponse, error) {
 
 	httpTimeout := httpTimeout(timeout)
 
-	hostID, err := c.GetHostID(hostName)
+	hostID, err := c.GetHostIDCtx(ctx, hostName)
 	if err != nil {
 		return nil, err
 	}
 
-	hostMappingsOnServer, err := c.GetHostMappings(httpTimeout)
+	hostMappingsOnServer, err := c.GetHostMappingsCtx(ctx, httpTimeout)
 	if err != nil {
 		return nil, err
 	}
@@ -335,7 +458,7 @@ func (c *Credentials) DeleteHostMappings(hostName string, timeout ...int) (*Dele
 	// slice for processing
 	var mappingIDs []int
 	for _, mapping := range hostMappingsOnServer {
-		if mapping.Host.Ref == fmt.Sprintf("/hosts/%d", hostID) {
+		if mapping.Host == HostRef(hostID) {
 			mappingIDs = append(mappingIDs, mapping.ID)
 		}
 
@@ -349,7 +472,7 @@ func (c *Credentials) DeleteHostMappings(hostName string, timeout ...int) (*Dele
 	// Loop through every mapping id in the mappingIDs slice and execute a delete call on that
 	// id
 	for _, id := range mappingIDs {
-		_, err := c.Delete(fmt.Sprintf("/mappings/%d", id), httpTimeout)
+		_, err := c.DeleteCtx(ctx, fmt.Sprintf("/mappings/%d", id), httpTimeout)
 		if err != nil {
 			return nil, err
 		}
@@ -366,20 +489,25 @@ func (c *Credentials) DeleteHostMappings(hostName string, timeout ...int) (*Dele
 
 // DeleteHostVolumeMapping removes a single Volume Mapping from a Host.
 func (c *Credentials) DeleteHostVolumeMapping(hostName, volumeName string, timeout ...int) (*DeleteResponse, error) {
+	return c.DeleteHostVolumeMappingCtx(context.Background(), hostName, volumeName, timeout...)
+}
+
+// DeleteHostVolumeMappingCtx is the context-aware form of DeleteHostVolumeMapping.
+func (c *Credentials) DeleteHostVolumeMappingCtx(ctx context.Context, hostName, volumeName string, timeout ...int) (*DeleteResponse, error) {
 
 	httpTimeout := httpTimeout(timeout)
 
-	hostID, err := c.GetHostID(hostName)
+	hostID, err := c.GetHostIDCtx(ctx, hostName)
 	if err != nil {
 		return nil, err
 	}
 
-	volumeID, err := c.GetVolumeID(volumeName)
+	volumeID, err := c.GetVolumeIDCtx(ctx, volumeName)
 	if err != nil {
 		return nil, err
 	}
 
-	hostMappingsOnServer, err := c.GetHostMappings(httpTimeout)
+	hostMappingsOnServer, err := c.GetHostMappingsCtx(ctx, httpTimeout)
 	if err != nil {
 		return nil, err
 	}
@@ -388,8 +516,8 @@ func (c *Credentials) DeleteHostVolumeMapping(hostName, volumeName string, timeo
 	// results
 	mappingID := -1
 	for _, mapping := range hostMappingsOnServer {
-		if mapping.Volume.Ref == fmt.Sprintf("/volumes/%d", volumeID) {
-			if mapping.Host.Ref == fmt.Sprintf("/hosts/%d", hostID) {
+		if mapping.Volume == VolumeRef(volumeID) {
+			if mapping.Host == HostRef(hostID) {
 				mappingID = mapping.ID
 
 			}
@@ -402,14 +530,14 @@ func (c *Credentials) DeleteHostVolumeMapping(hostName, volumeName string, timeo
 		return nil, fmt.Errorf("No %s Volume Mappings found on the Host '%s'", volumeName, hostName)
 	}
 
-	apiRequest, err := c.Delete(fmt.Sprintf("/mappings/%d", mappingID), httpTimeout)
+	apiRequest, err := c.DeleteCtx(ctx, fmt.Sprintf("/mappings/%d", mappingID), httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 
 	// Convert the API Response (map[string]interface{}) to a struct
 	var apiResponse DeleteResponse
-	mapErr := mapstructure.Decode(apiRequest, &apiResponse)
+	mapErr := decode(apiRequest, &apiResponse)
 	if mapErr != nil {
 		return nil, mapErr
 	}
@@ -419,20 +547,25 @@ func (c *Credentials) DeleteHostVolumeMapping(hostName, volumeName string, timeo
 
 // DeleteHostVolumeGroupMapping removes a single Volume Group Mapping from a Host.
 func (c *Credentials) DeleteHostVolumeGroupMapping(hostName, volumeGroupName string, timeout ...int) (*DeleteResponse, error) {
+	return c.DeleteHostVolumeGroupMappingCtx(context.Background(), hostName, volumeGroupName, timeout...)
+}
+
+// DeleteHostVolumeGroupMappingCtx is the context-aware form of DeleteHostVolumeGroupMapping.
+func (c *Credentials) DeleteHostVolumeGroupMappingCtx(ctx context.Context, hostName, volumeGroupName string, timeout ...int) (*DeleteResponse, error) {
 
 	httpTimeout := httpTimeout(timeout)
 
-	hostID, err := c.GetHostID(hostName)
+	hostID, err := c.GetHostIDCtx(ctx, hostName)
 	if err != nil {
 		return nil, err
 	}
 
-	volumeGroupID, err := c.GetVolumeGroupID(volumeGroupName)
+	volumeGroupID, err := c.GetVolumeGroupIDCtx(ctx, volumeGroupName)
 	if err != nil {
 		return nil, err
 	}
 
-	hostMappingsOnServer, err := c.GetHostMappings(httpTimeout)
+	hostMappingsOnServer, err := c.GetHostMappingsCtx(ctx, httpTimeout)
 	if err != nil {
 		return nil, err
 	}
@@ -441,8 +574,8 @@ func (c *Credentials) DeleteHostVolumeGroupMapping(hostName, volumeGroupName str
 	// results
 	mappingID := -1
 	for _, mapping := range hostMappingsOnServer {
-		if mapping.Volume.Ref == fmt.Sprintf("/volume_groups/%d", volumeGroupID) {
-			if mapping.Host.Ref == fmt.Sprintf("/hosts/%d", hostID) {
+		if mapping.Volume == VolumeGroupRef(volumeGroupID) {
+			if mapping.Host == HostRef(hostID) {
 				mappingID = mapping.ID
 
 			}
@@ -455,14 +588,14 @@ func (c *Credentials) DeleteHostVolumeGroupMapping(hostName, volumeGroupName str
 		return nil, fmt.Errorf("No %s Volume Group Mappings found on the Host '%s'", volumeGroupName, hostName)
 	}
 
-	apiRequest, err := c.Delete(fmt.Sprintf("/mappings/%d", mappingID), httpTimeout)
+	apiRequest, err := c.DeleteCtx(ctx, fmt.Sprintf("/mappings/%d", mappingID), httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 
 	// Convert the API Response (map[string]interface{}) to a struct
 	var apiResponse DeleteResponse
-	mapErr := mapstructure.Decode(apiRequest, &apiResponse)
+	mapErr := decode(apiRequest, &apiResponse)
 	if mapErr != nil {
 		return nil, mapErr
 	}
@@ -472,58 +605,33 @@ func (c *Credentials) DeleteHostVolumeGroupMapping(hostName, volumeGroupName str
 
 // GetHostID provides the ID for the provided Host name.
 func (c *Credentials) GetHostID(name string, timeout ...int) (int, error) {
+	return c.GetHostIDCtx(context.Background(), name, timeout...)
+}
 
-	httpTimeout := httpTimeout(timeout)
-
-	objectsOnServer, err := c.GetHosts(httpTimeout)
-	if err != nil {
-		return 0, err
-	}
-
-	// Set objectID to a value (-1) that can not be returned by the server
-	objectID := -1
-	for _, object := range objectsOnServer.Hits {
-		if object.Name == name {
-			objectID = object.ID
-		}
-
-	}
-
-	// If the objectID has not been updated (i.e not found on the server) return an error message
-	if objectID == -1 {
-		return 0, fmt.Errorf("The server does not contain a Host named '%s'", name)
-	}
+// GetHostIDCtx is the context-aware form of GetHostID.
+func (c *Credentials) GetHostIDCtx(ctx context.Context, name string, timeout ...int) (int, error) {
 
-	return objectID, nil
+	httpTimeout := httpTimeout(timeout)
 
+	return c.lookupID(ctx, KindHost, name, httpTimeout)
 }
 
 // GetHostName provides the name of a Host given its ID.
 func (c *Credentials) GetHostName(id int, timeout ...int) (string, error) {
+	return c.GetHostNameCtx(context.Background(), id, timeout...)
+}
+
+// GetHostNameCtx is the context-aware form of GetHostName.
+func (c *Credentials) GetHostNameCtx(ctx context.Context, id int, timeout ...int) (string, error) {
 
 	httpTimeout := httpTimeout(timeout)
 
-	objectsOnServer, err := c.GetHosts(httpTimeout)
+	names, err := c.resolveNames(ctx, KindHost, []int{id}, httpTimeout)
 	if err != nil {
 		return "", err
 	}
 
-	// Set objectID to a value (-1) that can not be returned by the server
-	objectName := ""
-	for _, object := range objectsOnServer.Hits {
-		if object.ID == id {
-			objectName = object.Name
-		}
-
-	}
-
-	// If the objectName has not been updated (i.e not found on the server) return an error message
-	if objectName == "" {
-		return "", fmt.Errorf("The server does not contain a Host with the ID of '%d'", id)
-	}
-
-	return objectName, nil
-
+	return names[id], nil
 }
 
 // // GetHostGroupName provides the name of a Host Group given its ID.
@@ -556,10 +664,15 @@ func (c *Credentials) GetHostName(id int, timeout ...int) (string, error) {
 
 // CreateHostPWWN adds a PWWN to a Host.
 func (c *Credentials) CreateHostPWWN(hostName, PWWN string, timeout ...int) (*CreateHostPWWNResponse, error) {
+	return c.CreateHostPWWNCtx(context.Background(), hostName, PWWN, timeout...)
+}
+
+// CreateHostPWWNCtx is the context-aware form of CreateHostPWWN.
+func (c *Credentials) CreateHostPWWNCtx(ctx context.Context, hostName, PWWN string, timeout ...int) (*CreateHostPWWNResponse, error) {
 
 	httpTimeout := httpTimeout(timeout)
 
-	hostID, err := c.GetHostID(hostName)
+	hostID, err := c.GetHostIDCtx(ctx, hostName)
 	if err != nil {
 		return nil, err
 	}
@@ -571,14 +684,14 @@ func (c *Credentials) CreateHostPWWN(hostName, PWWN string, timeout ...int) (*Cr
 	config["pwwn"] = PWWN
 	config["host"] = hostConfig
 
-	apiRequest, err := c.Post("/host_fc_ports", config, httpTimeout)
+	apiRequest, err := c.PostCtx(ctx, "/host_fc_ports", config, httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 
 	// Convert the API Response (map[string]interface{}) to a struct
 	var apiResponse CreateHostPWWNResponse
-	mapErr := mapstructure.Decode(apiRequest, &apiResponse)
+	mapErr := decode(apiRequest, &apiResponse)
 	if mapErr != nil {
 		return nil, mapErr
 	}
@@ -591,22 +704,27 @@ func (c *Credentials) CreateHostPWWN(hostName, PWWN string, timeout ...int) (*Cr
 // The returned []IndividualHostPWWNResponse slice only contains information on the Host PWWN mappings and not
 // the full response of the API call. If no PWWNs have been added to a Host, an empty slice will be returned.
 func (c *Credentials) GetHostPWWN(hostName string, timeout ...int) ([]IndividualHostPWWNResponse, error) {
+	return c.GetHostPWWNCtx(context.Background(), hostName, timeout...)
+}
+
+// GetHostPWWNCtx is the context-aware form of GetHostPWWN.
+func (c *Credentials) GetHostPWWNCtx(ctx context.Context, hostName string, timeout ...int) ([]IndividualHostPWWNResponse, error) {
 
 	httpTimeout := httpTimeout(timeout)
 
-	hostID, err := c.GetHostID(hostName)
+	hostID, err := c.GetHostIDCtx(ctx, hostName)
 	if err != nil {
 		return nil, err
 	}
 
-	apiRequest, err := c.Get("/host_fc_ports", httpTimeout)
+	apiRequest, err := c.getAllCtx(ctx, "/host_fc_ports", nil, httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 
 	// Convert the API Response (map[string]interface{}) to a struct
 	var apiResponse GetHostPWWNResponse
-	mapErr := mapstructure.Decode(apiRequest, &apiResponse)
+	mapErr := decode(apiRequest, &apiResponse)
 	if mapErr != nil {
 		return nil, mapErr
 	}
@@ -614,7 +732,7 @@ func (c *Credentials) GetHostPWWN(hostName string, timeout ...int) ([]Individual
 	// Filter out all host mappings from the apiRequest
 	var hostPWWN []IndividualHostPWWNResponse
 	for _, value := range apiResponse.Hits {
-		if value.Host.Ref == fmt.Sprintf("/hosts/%d", hostID) {
+		if value.Host == HostRef(hostID) {
 			hostPWWN = append(hostPWWN, value)
 
 		}
@@ -625,10 +743,15 @@ func (c *Credentials) GetHostPWWN(hostName string, timeout ...int) ([]Individual
 
 // DeleteHostPWWN removes all PWWNs from a Host.
 func (c *Credentials) DeleteHostPWWN(hostName string, timeout ...int) (*DeleteResponse, error) {
+	return c.DeleteHostPWWNCtx(context.Background(), hostName, timeout...)
+}
+
+// DeleteHostPWWNCtx is the context-aware form of DeleteHostPWWN.
+func (c *Credentials) DeleteHostPWWNCtx(ctx context.Context, hostName string, timeout ...int) (*DeleteResponse, error) {
 
 	httpTimeout := httpTimeout(timeout)
 
-	hostPWWNs, err := c.GetHostPWWN(hostName)
+	hostPWWNs, err := c.GetHostPWWNCtx(ctx, hostName)
 	if err != nil {
 		return nil, err
 	}
@@ -643,7 +766,7 @@ func (c *Credentials) DeleteHostPWWN(hostName string, timeout ...int) (*DeleteRe
 	var apiResponse DeleteResponse
 	if len(pwwnToDelete) != 0 {
 		for _, id := range pwwnToDelete {
-			_, err := c.Delete(fmt.Sprintf("/host_fc_ports/%d", id), httpTimeout)
+			_, err := c.DeleteCtx(ctx, fmt.Sprintf("/host_fc_ports/%d", id), httpTimeout)
 			if err != nil {
 				return nil, err
 			}
@@ -658,10 +781,15 @@ func (c *Credentials) DeleteHostPWWN(hostName string, timeout ...int) (*DeleteRe
 
 // DeleteHostIndividualPWWN removes a specific PWWN from a Host.
 func (c *Credentials) DeleteHostIndividualPWWN(hostName, pwwn string, timeout ...int) (*DeleteResponse, error) {
+	return c.DeleteHostIndividualPWWNCtx(context.Background(), hostName, pwwn, timeout...)
+}
+
+// DeleteHostIndividualPWWNCtx is the context-aware form of DeleteHostIndividualPWWN.
+func (c *Credentials) DeleteHostIndividualPWWNCtx(ctx context.Context, hostName, pwwn string, timeout ...int) (*DeleteResponse, error) {
 
 	httpTimeout := httpTimeout(timeout)
 
-	hostPWWNs, err := c.GetHostPWWN(hostName)
+	hostPWWNs, err := c.GetHostPWWNCtx(ctx, hostName)
 	if err != nil {
 		return nil, err
 	}
@@ -683,7 +811,7 @@ func (c *Credentials) DeleteHostIndividualPWWN(hostName, pwwn string, timeout ..
 	// Loop through every id in the pwwnToDelete slice and execute a delete call on that
 	// id
 	for _, id := range pwwnToDelete {
-		_, err := c.Delete(fmt.Sprintf("/host_fc_ports/%d", id), httpTimeout)
+		_, err := c.DeleteCtx(ctx, fmt.Sprintf("/host_fc_ports/%d", id), httpTimeout)
 		if err != nil {
 			return nil, err
 		}
@@ -703,22 +831,27 @@ func (c *Credentials) DeleteHostIndividualPWWN(hostName, pwwn string, timeout ..
 // The returned []IndividualHostIQNResponse slice only contains information on the Host IQN mappings and not
 // the full response of the API call. If no IQNs have been added to a Host, an empty slice will be returned.
 func (c *Credentials) GetHostIQN(hostName string, timeout ...int) ([]IndividualHostIQNResponse, error) {
+	return c.GetHostIQNCtx(context.Background(), hostName, timeout...)
+}
+
+// GetHostIQNCtx is the context-aware form of GetHostIQN.
+func (c *Credentials) GetHostIQNCtx(ctx context.Context, hostName string, timeout ...int) ([]IndividualHostIQNResponse, error) {
 
 	httpTimeout := httpTimeout(timeout)
 
-	hostID, err := c.GetHostID(hostName)
+	hostID, err := c.GetHostIDCtx(ctx, hostName)
 	if err != nil {
 		return nil, err
 	}
 
-	apiRequest, err := c.Get("/host_iqns", httpTimeout)
+	apiRequest, err := c.getAllCtx(ctx, "/host_iqns", nil, httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 
 	// Convert the API Response (map[string]interface{}) to a struct
 	var apiResponse GetHostIQNResponse
-	mapErr := mapstructure.Decode(apiRequest, &apiResponse)
+	mapErr := decode(apiRequest, &apiResponse)
 	if mapErr != nil {
 		return nil, mapErr
 	}
@@ -726,7 +859,7 @@ func (c *Credentials) GetHostIQN(hostName string, timeout ...int) ([]IndividualH
 	var hostIQN []IndividualHostIQNResponse
 	for _, value := range apiResponse.Hits {
 
-		if value.Host.Ref == fmt.Sprintf("/hosts/%d", hostID) {
+		if value.Host == HostRef(hostID) {
 			hostIQN = append(hostIQN, value)
 
 		}
@@ -737,10 +870,15 @@ func (c *Credentials) GetHostIQN(hostName string, timeout ...int) ([]IndividualH
 
 // DeleteHostIndividualIQN removes a specific IQN from a Host.
 func (c *Credentials) DeleteHostIndividualIQN(hostName, iqn string, timeout ...int) (*DeleteResponse, error) {
+	return c.DeleteHostIndividualIQNCtx(context.Background(), hostName, iqn, timeout...)
+}
+
+// DeleteHostIndividualIQNCtx is the context-aware form of DeleteHostIndividualIQN.
+func (c *Credentials) DeleteHostIndividualIQNCtx(ctx context.Context, hostName, iqn string, timeout ...int) (*DeleteResponse, error) {
 
 	httpTimeout := httpTimeout(timeout)
 
-	hostIQNs, err := c.GetHostIQN(hostName)
+	hostIQNs, err := c.GetHostIQNCtx(ctx, hostName)
 	if err != nil {
 		return nil, err
 	}
@@ -759,7 +897,7 @@ func (c *Credentials) DeleteHostIndividualIQN(hostName, iqn string, timeout ...i
 	}
 
 	for _, id := range iqnToDelete {
-		_, err := c.Delete(fmt.Sprintf("/host_iqns/%d", id), httpTimeout)
+		_, err := c.DeleteCtx(ctx, fmt.Sprintf("/host_iqns/%d", id), httpTimeout)
 		if err != nil {
 			return nil, err
 		}
@@ -776,10 +914,15 @@ func (c *Credentials) DeleteHostIndividualIQN(hostName, iqn string, timeout ...i
 
 // DeleteHostIQN remove all IQN's from a Host if present.
 func (c *Credentials) DeleteHostIQN(hostName string, timeout ...int) (*DeleteResponse, error) {
+	return c.DeleteHostIQNCtx(context.Background(), hostName, timeout...)
+}
+
+// DeleteHostIQNCtx is the context-aware form of DeleteHostIQN.
+func (c *Credentials) DeleteHostIQNCtx(ctx context.Context, hostName string, timeout ...int) (*DeleteResponse, error) {
 
 	httpTimeout := httpTimeout(timeout)
 
-	hostIQNs, err := c.GetHostIQN(hostName)
+	hostIQNs, err := c.GetHostIQNCtx(ctx, hostName)
 	if err != nil {
 		return nil, err
 	}
@@ -794,7 +937,7 @@ func (c *Credentials) DeleteHostIQN(hostName string, timeout ...int) (*DeleteRes
 	var apiResponse DeleteResponse
 	if len(iqnToDelete) != 0 {
 		for _, id := range iqnToDelete {
-			_, err := c.Delete(fmt.Sprintf("/host_iqns/%d", id), httpTimeout)
+			_, err := c.DeleteCtx(ctx, fmt.Sprintf("/host_iqns/%d", id), httpTimeout)
 			if err != nil {
 				return nil, err
 			}
@@ -810,10 +953,15 @@ func (c *Credentials) DeleteHostIQN(hostName string, timeout ...int) (*DeleteRes
 
 // CreateHostIQN adds a IQN to a Host.
 func (c *Credentials) CreateHostIQN(hostName, IQN string, timeout ...int) (*CreateHostIQNResponse, error) {
+	return c.CreateHostIQNCtx(context.Background(), hostName, IQN, timeout...)
+}
+
+// CreateHostIQNCtx is the context-aware form of CreateHostIQN.
+func (c *Credentials) CreateHostIQNCtx(ctx context.Context, hostName, IQN string, timeout ...int) (*CreateHostIQNResponse, error) {
 
 	httpTimeout := httpTimeout(timeout)
 
-	hostID, err := c.GetHostID(hostName)
+	hostID, err := c.GetHostIDCtx(ctx, hostName)
 	if err != nil {
 		return nil, err
 	}
@@ -825,14 +973,14 @@ func (c *Credentials) CreateHostIQN(hostName, IQN string, timeout ...int) (*Crea
 	config["iqn"] = IQN
 	config["host"] = hostConfig
 
-	apiRequest, err := c.Post("/host_iqns", config, httpTimeout)
+	apiRequest, err := c.PostCtx(ctx, "/host_iqns", config, httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 
 	// Convert the API Response (map[string]interface{}) to a struct
 	var apiResponse CreateHostIQNResponse
-	mapErr := mapstructure.Decode(apiRequest, &apiResponse)
+	mapErr := decode(apiRequest, &apiResponse)
 	if mapErr != nil {
 		return nil, mapErr
 	}
@@ -842,15 +990,20 @@ func (c *Credentials) CreateHostIQN(hostName, IQN string, timeout ...int) (*Crea
 
 // CreateHostHostGroupMapping adds a Host to a Host Group.
 func (c *Credentials) CreateHostHostGroupMapping(hostName, hostGroupName string, timeout ...int) (*CreateOrUpdateHostResponse, error) {
+	return c.CreateHostHostGroupMappingCtx(context.Background(), hostName, hostGroupName, timeout...)
+}
+
+// CreateHostHostGroupMappingCtx is the context-aware form of CreateHostHostGroupMapping.
+func (c *Credentials) CreateHostHostGroupMappingCtx(ctx context.Context, hostName, hostGroupName string, timeout ...int) (*CreateOrUpdateHostResponse, error) {
 
 	httpTimeout := httpTimeout(timeout)
 
-	hostID, err := c.GetHostID(hostName)
+	hostID, err := c.GetHostIDCtx(ctx, hostName)
 	if err != nil {
 		return nil, err
 	}
 
-	hostGroupID, err := c.GetHostGroupID(hostGroupName)
+	hostGroupID, err := c.GetHostGroupIDCtx(ctx, hostGroupName)
 	if err != nil {
 		return nil, err
 	}
@@ -861,14 +1014,14 @@ func (c *Credentials) CreateHostHostGroupMapping(hostName, hostGroupName string,
 	config := map[string]interface{}{}
 	config["host_group"] = hostGroupConfig
 
-	apiRequest, err := c.Patch(fmt.Sprintf("/hosts/%d", hostID), config, httpTimeout)
+	apiRequest, err := c.PatchCtx(ctx, fmt.Sprintf("/hosts/%d", hostID), config, httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 
 	// Convert the API Response (map[string]interface{}) to a struct
 	var apiResponse CreateOrUpdateHostResponse
-	mapErr := mapstructure.Decode(apiRequest, &apiResponse)
+	mapErr := decode(apiRequest, &apiResponse)
 	if mapErr != nil {
 		return nil, mapErr
 	}
@@ -879,42 +1032,47 @@ func (c *Credentials) CreateHostHostGroupMapping(hostName, hostGroupName string,
 
 // DeleteHostHostGroupMapping removes a Host to a Host Group.
 func (c *Credentials) DeleteHostHostGroupMapping(hostName, hostGroupName string, timeout ...int) (*CreateOrUpdateHostResponse, error) {
+	return c.DeleteHostHostGroupMappingCtx(context.Background(), hostName, hostGroupName, timeout...)
+}
+
+// DeleteHostHostGroupMappingCtx is the context-aware form of DeleteHostHostGroupMapping.
+func (c *Credentials) DeleteHostHostGroupMappingCtx(ctx context.Context, hostName, hostGroupName string, timeout ...int) (*CreateOrUpdateHostResponse, error) {
 
 	httpTimeout := httpTimeout(timeout)
 
-	hostGroupID, err := c.GetHostGroupID(hostGroupName, httpTimeout)
+	hostGroupID, err := c.GetHostGroupIDCtx(ctx, hostGroupName, httpTimeout)
 	if hostGroupID != 0 && err != nil {
 		return nil, err
 	}
 
-	hostID, err := c.GetHostID(hostName)
+	hostID, err := c.GetHostIDCtx(ctx, hostName)
 	if hostID != 0 && err != nil {
 		return nil, err
 	}
 
 	if hostGroupID != 0 && hostID != 0 {
-		hostsOnServer, err := c.GetHosts(httpTimeout)
+		hostsOnServer, err := c.GetHostCtx(ctx, hostName, httpTimeout)
 		if err != nil {
 			return nil, err
 		}
 
 		for _, host := range hostsOnServer.Hits {
 			if host.Name == hostName {
-				if host.HostGroup.Ref == fmt.Sprintf("/host_groups/%d", hostGroupID) {
+				if host.HostGroup == HostGroupRef(hostGroupID) {
 
 					hostGroupConfig := map[string]string{}
 
 					config := map[string]interface{}{}
 					config["host_group"] = hostGroupConfig
 
-					apiRequest, err := c.Patch(fmt.Sprintf("/hosts/%d", hostID), config, httpTimeout)
+					apiRequest, err := c.PatchCtx(ctx, fmt.Sprintf("/hosts/%d", hostID), config, httpTimeout)
 					if err != nil {
 						return nil, err
 					}
 
 					// Convert the API Response (map[string]interface{}) to a struct
 					var apiResponse CreateOrUpdateHostResponse
-					mapErr := mapstructure.Decode(apiRequest, &apiResponse)
+					mapErr := decode(apiRequest, &apiResponse)
 					if mapErr != nil {
 						return nil, mapErr
 					}
@@ -925,30 +1083,33 @@ func (c *Credentials) DeleteHostHostGroupMapping(hostName, hostGroupName string,
 			}
 
 		}
-	} 
-	
+	}
+
 	// return nil, fmt.Errorf("The Host %s is not a member of the %s Host Group", hostName, hostGroupName)
-	return nil,nil
+	return nil, nil
 }
 
 // GetHostByName submits a strict API query for host objects of a specific name.
 func (c *Credentials) GetHostByName(hostname string, timeout ...int) (*GetHostsResponse, error) {
+	return c.GetHostByNameCtx(context.Background(), hostname, timeout...)
+}
 
-	httpTimeout := httpTimeout(timeout)
+// GetHostByNameCtx is the context-aware form of GetHostByName.
+func (c *Credentials) GetHostByNameCtx(ctx context.Context, hostname string, timeout ...int) (*GetHostsResponse, error) {
 
-	enduri := ("/hosts?name__contains=" + hostname)
+	httpTimeout := httpTimeout(timeout)
 
-	apiRequest, err := c.Get(enduri, httpTimeout)
+	apiRequest, err := c.getAllCtx(ctx, "/hosts", Filter().Name().Contains(hostname), httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 
 	// Convert the API Response (map[string]interface{}) to a struct
 	var apiResponse GetHostsResponse
-	mapErr := mapstructure.Decode(apiRequest, &apiResponse)
+	mapErr := decode(apiRequest, &apiResponse)
 	if mapErr != nil {
 		return nil, mapErr
 	}
 
 	return &apiResponse, nil
-}
\ No newline at end of file
+}
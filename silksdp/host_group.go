@@ -1,16 +1,20 @@
 package silksdp
 
 import (
+	"context"
 	"fmt"
 	"strings"
-
-	"github.com/mitchellh/mapstructure"
 )
 
 // CreateHostGroup creates a new Host Group on the Silk server.
 //
 // allowDifferentHostTypes corresponds to the "Enable mixed host OS types" checkbox in the UI.
 func (c *Credentials) CreateHostGroup(name, description string, allowDifferentHostTypes bool, timeout ...int) (*CreateOrUpdateHostGroupResponse, error) {
+	return c.CreateHostGroupCtx(context.Background(), name, description, allowDifferentHostTypes, timeout...)
+}
+
+// CreateHostGroupCtx is the context-aware form of CreateHostGroup.
+func (c *Credentials) CreateHostGroupCtx(ctx context.Context, name, description string, allowDifferentHostTypes bool, timeout ...int) (*CreateOrUpdateHostGroupResponse, error) {
 
 	httpTimeout := httpTimeout(timeout)
 
@@ -19,14 +23,14 @@ func (c *Credentials) CreateHostGroup(name, description string, allowDifferentHo
 	config["description"] = description
 	config["allow_different_host_types"] = allowDifferentHostTypes
 
-	apiRequest, err := c.Post("/host_groups", config, httpTimeout)
+	apiRequest, err := c.PostCtx(ctx, "/host_groups", config, httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 
 	// Convert the API Response (map[string]interface{}) to a struct
 	var apiResponse CreateOrUpdateHostGroupResponse
-	mapErr := mapstructure.Decode(apiRequest, &apiResponse)
+	mapErr := decode(apiRequest, &apiResponse)
 	if mapErr != nil {
 		return nil, mapErr
 	}
@@ -36,17 +40,22 @@ func (c *Credentials) CreateHostGroup(name, description string, allowDifferentHo
 
 // GetHostGroups returns information on all Host Groups found on the Silk server.
 func (c *Credentials) GetHostGroups(timeout ...int) (*GetHostGroupsResponse, error) {
+	return c.GetHostGroupsCtx(context.Background(), timeout...)
+}
+
+// GetHostGroupsCtx is the context-aware form of GetHostGroups.
+func (c *Credentials) GetHostGroupsCtx(ctx context.Context, timeout ...int) (*GetHostGroupsResponse, error) {
 
 	httpTimeout := httpTimeout(timeout)
 
-	apiRequest, err := c.Get("/host_groups", httpTimeout)
+	apiRequest, err := c.getAllCtx(ctx, "/host_groups", nil, httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 
 	// Convert the API Response (map[string]interface{}) to a struct
 	var apiResponse GetHostGroupsResponse
-	mapErr := mapstructure.Decode(apiRequest, &apiResponse)
+	mapErr := decode(apiRequest, &apiResponse)
 	if mapErr != nil {
 		return nil, mapErr
 	}
@@ -54,18 +63,60 @@ func (c *Credentials) GetHostGroups(timeout ...int) (*GetHostGroupsResponse, err
 	return &apiResponse, nil
 }
 
-// UpdateHostGroup updates the Host with the provided config options.
+// FindHostGroups returns the Host Groups matching the provided filter, fetching every page of the
+// results (ex: silksdp.Filter().Name().In("cluster01", "cluster02")).
+func (c *Credentials) FindHostGroups(filter ListFilter, timeout ...int) (*GetHostGroupsResponse, error) {
+	return c.FindHostGroupsCtx(context.Background(), filter, timeout...)
+}
+
+// FindHostGroupsCtx is the context-aware form of FindHostGroups.
+func (c *Credentials) FindHostGroupsCtx(ctx context.Context, filter ListFilter, timeout ...int) (*GetHostGroupsResponse, error) {
+
+	apiRequest, err := c.getAllCtx(ctx, "/host_groups", filter, httpTimeout(timeout))
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert the API Response (map[string]interface{}) to a struct
+	var apiResponse GetHostGroupsResponse
+	mapErr := decode(apiRequest, &apiResponse)
+	if mapErr != nil {
+		return nil, mapErr
+	}
+
+	return &apiResponse, nil
+}
+
+// HostGroupUpdate holds the changes applied to a Host Group by ApplyHostGroupUpdate(). Only the fields that are set are
+// sent to the Silk server.
+type HostGroupUpdate struct {
+	// Name renames the Host Group.
+	Name *string
+	// Description replaces the description of the Host Group.
+	Description *string
+	// AllowDifferentHostTypes allows Hosts of different types to be members of the Host Group.
+	AllowDifferentHostTypes *bool
+}
+
+// UpdateHostGroup updates the Host Group with the provided config options.
 //
 // Valid keys for the config map[string]interface{} are: description and allow_different_host_types.
-// Valid config keys are:
+//
+// Deprecated: Use ApplyHostGroupUpdate() and a HostGroupUpdate, which are validated at compile time.
 func (c *Credentials) UpdateHostGroup(name string, config map[string]interface{}, timeout ...int) (*CreateOrUpdateHostGroupResponse, error) {
+	return c.UpdateHostGroupCtx(context.Background(), name, config, timeout...)
+}
+
+// UpdateHostGroupCtx is the context-aware form of UpdateHostGroup.
+//
+// Deprecated: Use ApplyHostGroupUpdateCtx() and a HostGroupUpdate, which are validated at compile time.
+func (c *Credentials) UpdateHostGroupCtx(ctx context.Context, name string, config map[string]interface{}, timeout ...int) (*CreateOrUpdateHostGroupResponse, error) {
 	httpTimeout := httpTimeout(timeout)
 
 	// Validate that the user provided keys are valid for this API
 	validUpdateKeys := []string{"description", "allow_different_host_types"}
 	var invalidUserProvidedKeys []string
 	for key := range config {
-
 		if c.stringInSlice(validUpdateKeys, key) == false {
 			invalidUserProvidedKeys = append(invalidUserProvidedKeys, key)
 		}
@@ -76,19 +127,52 @@ func (c *Credentials) UpdateHostGroup(name string, config map[string]interface{}
 		return nil, fmt.Errorf("The provided 'config' parameter contains invalid keys. 'description' and 'allow_different_host_types' are the only valid choices")
 	}
 
-	hostGroupID, err := c.GetHostGroupID(name, httpTimeout)
+	return c.updateHostGroup(ctx, name, config, httpTimeout)
+}
+
+// ApplyHostGroupUpdate applies the provided changes to a Host Group. Only the fields of the update that are set are
+// sent to the Silk server, and an error is returned if none are.
+func (c *Credentials) ApplyHostGroupUpdate(name string, update HostGroupUpdate, timeout ...int) (*CreateOrUpdateHostGroupResponse, error) {
+	return c.ApplyHostGroupUpdateCtx(context.Background(), name, update, timeout...)
+}
+
+// ApplyHostGroupUpdateCtx is the context-aware form of ApplyHostGroupUpdate.
+func (c *Credentials) ApplyHostGroupUpdateCtx(ctx context.Context, name string, update HostGroupUpdate, timeout ...int) (*CreateOrUpdateHostGroupResponse, error) {
+
+	config := map[string]interface{}{}
+	if update.Name != nil {
+		config["name"] = *update.Name
+	}
+	if update.Description != nil {
+		config["description"] = *update.Description
+	}
+	if update.AllowDifferentHostTypes != nil {
+		config["allow_different_host_types"] = *update.AllowDifferentHostTypes
+	}
+
+	if len(config) == 0 {
+		return nil, errNoChanges
+	}
+
+	return c.updateHostGroup(ctx, name, config, httpTimeout(timeout))
+}
+
+// updateHostGroup sends the PATCH request shared by UpdateHostGroupCtx() and ApplyHostGroupUpdateCtx().
+func (c *Credentials) updateHostGroup(ctx context.Context, name string, config map[string]interface{}, httpTimeout int) (*CreateOrUpdateHostGroupResponse, error) {
+
+	hostGroupID, err := c.GetHostGroupIDCtx(ctx, name, httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 
-	apiRequest, err := c.Patch(fmt.Sprintf("/host_groups/%d", hostGroupID), config, httpTimeout)
+	apiRequest, err := c.PatchCtx(ctx, fmt.Sprintf("/host_groups/%d", hostGroupID), config, httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 
 	// Convert the API Response (map[string]interface{}) to a struct
 	var apiResponse CreateOrUpdateHostGroupResponse
-	mapErr := mapstructure.Decode(apiRequest, &apiResponse)
+	mapErr := decode(apiRequest, &apiResponse)
 	if mapErr != nil {
 		return nil, mapErr
 	}
@@ -99,35 +183,40 @@ func (c *Credentials) UpdateHostGroup(name string, config map[string]interface{}
 
 // DeleteHostGroup deletes a Host Group from the Silk server.
 func (c *Credentials) DeleteHostGroup(name string, timeout ...int) (*DeleteResponse, error) {
+	return c.DeleteHostGroupCtx(context.Background(), name, timeout...)
+}
+
+// DeleteHostGroupCtx is the context-aware form of DeleteHostGroup.
+func (c *Credentials) DeleteHostGroupCtx(ctx context.Context, name string, timeout ...int) (*DeleteResponse, error) {
 
 	httpTimeout := httpTimeout(timeout)
 
-	_, err := c.DeleteHostGroupMappings(name)
-	if err != nil{
-		return nil,err
+	_, err := c.DeleteHostGroupMappingsCtx(ctx, name)
+	if err != nil {
+		return nil, err
 	}
 
-	hostsInHostGroup,err := c.GetHostGroupHosts(name,httpTimeout)
-	for _,hostName := range hostsInHostGroup {
-		_,err := c.DeleteHostHostGroupMapping(hostName,name)
-		if err != nil{
-			return nil,err
+	hostsInHostGroup, err := c.GetHostGroupHostsCtx(ctx, name, httpTimeout)
+	for _, hostName := range hostsInHostGroup {
+		_, err := c.DeleteHostHostGroupMappingCtx(ctx, hostName, name)
+		if err != nil {
+			return nil, err
 		}
-	} 
-	
-	hostGroupID, err := c.GetHostGroupID(name, httpTimeout)
+	}
+
+	hostGroupID, err := c.GetHostGroupIDCtx(ctx, name, httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 
-	apiRequest, err := c.Delete(fmt.Sprintf("/host_groups/%d", hostGroupID), httpTimeout)
+	apiRequest, err := c.DeleteCtx(ctx, fmt.Sprintf("/host_groups/%d", hostGroupID), httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 
 	// Convert the API Response (map[string]interface{}) to a struct
 	var apiResponse DeleteResponse
-	mapErr := mapstructure.Decode(apiRequest, &apiResponse)
+	mapErr := decode(apiRequest, &apiResponse)
 	if mapErr != nil {
 		return nil, mapErr
 	}
@@ -138,68 +227,51 @@ func (c *Credentials) DeleteHostGroup(name string, timeout ...int) (*DeleteRespo
 
 // GetHostGroupID provides the ID for the provided Host Group name.
 func (c *Credentials) GetHostGroupID(name string, timeout ...int) (int, error) {
+	return c.GetHostGroupIDCtx(context.Background(), name, timeout...)
+}
 
-	httpTimeout := httpTimeout(timeout)
-
-	objectsOnServer, err := c.GetHostGroups(httpTimeout)
-	if err != nil {
-		return 0, err
-	}
-
-	// Set objectID to a value (-1) that can not be returned by the server
-	objectID := -1
-	for _, object := range objectsOnServer.Hits {
-		if object.Name == name {
-			objectID = object.ID
-		}
-
-	}
-
-	// If the objectID has not been updated (i.e not found on the server) return an error message
-	if objectID == -1 {
-		return 0, fmt.Errorf("The server does not contain a Host Group named '%s'", name)
-	}
+// GetHostGroupIDCtx is the context-aware form of GetHostGroupID.
+func (c *Credentials) GetHostGroupIDCtx(ctx context.Context, name string, timeout ...int) (int, error) {
 
-	return objectID, nil
+	httpTimeout := httpTimeout(timeout)
 
+	return c.lookupID(ctx, KindHostGroup, name, httpTimeout)
 }
 
 // GetHostGroupName provides the name of a Host Group given its ID.
 func (c *Credentials) GetHostGroupName(id int, timeout ...int) (string, error) {
+	return c.GetHostGroupNameCtx(context.Background(), id, timeout...)
+}
+
+// GetHostGroupNameCtx is the context-aware form of GetHostGroupName.
+func (c *Credentials) GetHostGroupNameCtx(ctx context.Context, id int, timeout ...int) (string, error) {
 
 	httpTimeout := httpTimeout(timeout)
 
-	apiRequest, err := c.Get(fmt.Sprintf("/host_groups?id__in=%d", id), httpTimeout)
+	names, err := c.resolveNames(ctx, KindHostGroup, []int{id}, httpTimeout)
 	if err != nil {
 		return "", err
 	}
 
-	// Convert the API Response (map[string]interface{}) to a struct
-	var apiResponse GetHostGroupsResponse
-	mapErr := mapstructure.Decode(apiRequest, &apiResponse)
-	if mapErr != nil {
-		return "", mapErr
-	}
-
-	for _, hostGroup := range apiResponse.Hits {
-		return hostGroup.Name, nil
-	}
-
-	return "", fmt.Errorf("Did not found hostgroup with id=%d", id)
-
+	return names[id], nil
 }
 
 // CreateHostGroupVolumeMapping will map a Host to the provided Volume.
 func (c *Credentials) CreateHostGroupVolumeMapping(hostGroupName, volumeName string, timeout ...int) (*CreateHostVolumeMappingResponse, error) {
+	return c.CreateHostGroupVolumeMappingCtx(context.Background(), hostGroupName, volumeName, timeout...)
+}
+
+// CreateHostGroupVolumeMappingCtx is the context-aware form of CreateHostGroupVolumeMapping.
+func (c *Credentials) CreateHostGroupVolumeMappingCtx(ctx context.Context, hostGroupName, volumeName string, timeout ...int) (*CreateHostVolumeMappingResponse, error) {
 
 	httpTimeout := httpTimeout(timeout)
 
-	hostGroupID, err := c.GetHostGroupID(hostGroupName, httpTimeout)
+	hostGroupID, err := c.GetHostGroupIDCtx(ctx, hostGroupName, httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 
-	volumeID, err := c.GetVolumeID(volumeName, httpTimeout)
+	volumeID, err := c.GetVolumeIDCtx(ctx, volumeName, httpTimeout)
 	if err != nil {
 		return nil, err
 	}
@@ -214,14 +286,14 @@ func (c *Credentials) CreateHostGroupVolumeMapping(hostGroupName, volumeName str
 	config["host"] = hostConfig
 	config["volume"] = volumeConfig
 
-	apiRequest, err := c.Post("/mappings", config, httpTimeout)
+	apiRequest, err := c.PostCtx(ctx, "/mappings", config, httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 
 	// Convert the API Response (map[string]interface{}) to a struct
 	var apiResponse CreateHostVolumeMappingResponse
-	mapErr := mapstructure.Decode(apiRequest, &apiResponse)
+	mapErr := decode(apiRequest, &apiResponse)
 	if mapErr != nil {
 		return nil, mapErr
 	}
@@ -262,7 +334,7 @@ func (c *Credentials) CreateHostGroupVolumeMapping(hostGroupName, volumeName str
 
 // 	// Convert the API Response (map[string]interface{}) to a struct
 // 	var apiResponse CreateHostVolumeMappingResponse
-// 	mapErr := mapstructure.Decode(apiRequest, &apiResponse)
+// 	mapErr := decode(apiRequest, &apiResponse)
 // 	if mapErr != nil {
 // 		return nil, mapErr
 // 	}
@@ -303,7 +375,7 @@ func (c *Credentials) CreateHostGroupVolumeMapping(hostGroupName, volumeName str
 // 		}
 
 // 		var apiResponse CreateHostVolumeMappingResponse
-// 		mapErr := mapstructure.Decode(apiRequest, &apiResponse)
+// 		mapErr := decode(apiRequest, &apiResponse)
 // 		if mapErr != nil {
 // 			return nil, mapErr
 // 		}
@@ -314,23 +386,29 @@ func (c *Credentials) CreateHostGroupVolumeMapping(hostGroupName, volumeName str
 // 	return hostGroupVolumeMappingResponse, nil
 // }
 
+// CreateHostGroupVolumeGroupMapping maps every Volume in a Volume Group to a Host Group.
 func (c *Credentials) CreateHostGroupVolumeGroupMapping(hostGroupName string, volumeGroupName string, timeout ...int) ([]CreateHostVolumeMappingResponse, error) {
+	return c.CreateHostGroupVolumeGroupMappingCtx(context.Background(), hostGroupName, volumeGroupName, timeout...)
+}
+
+// CreateHostGroupVolumeGroupMappingCtx is the context-aware form of CreateHostGroupVolumeGroupMapping.
+func (c *Credentials) CreateHostGroupVolumeGroupMappingCtx(ctx context.Context, hostGroupName string, volumeGroupName string, timeout ...int) ([]CreateHostVolumeMappingResponse, error) {
 
 	httpTimeout := httpTimeout(timeout)
 	var hostGroupVolumeMappingResponse []CreateHostVolumeMappingResponse
 
-	volumesInVolumeGroup, err := c.GetVolumeGroupVolumes(volumeGroupName, httpTimeout)
+	volumesInVolumeGroup, err := c.GetVolumeGroupVolumesCtx(ctx, volumeGroupName, httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 	for _, volume := range volumesInVolumeGroup {
 
-		apiResponse, err := c.CreateHostGroupVolumeMapping(hostGroupName,volume,httpTimeout)
+		apiResponse, err := c.CreateHostGroupVolumeMappingCtx(ctx, hostGroupName, volume, httpTimeout)
 		if err != nil {
 			return nil, err
 		}
 
-		hostGroupVolumeMappingResponse = append(hostGroupVolumeMappingResponse,*apiResponse)
+		hostGroupVolumeMappingResponse = append(hostGroupVolumeMappingResponse, *apiResponse)
 
 	}
 	return hostGroupVolumeMappingResponse, nil
@@ -341,17 +419,22 @@ func (c *Credentials) CreateHostGroupVolumeGroupMapping(hostGroupName string, vo
 // The returned []HostMappingRespons slice only contains information on the Host Groups and not
 // the full response of the API call. If no host mappings are found, an empty slice will be returned.
 func (c *Credentials) GetHostGroupMappings(timeout ...int) ([]IndividualHostMappingResponse, error) {
+	return c.GetHostGroupMappingsCtx(context.Background(), timeout...)
+}
+
+// GetHostGroupMappingsCtx is the context-aware form of GetHostGroupMappings.
+func (c *Credentials) GetHostGroupMappingsCtx(ctx context.Context, timeout ...int) ([]IndividualHostMappingResponse, error) {
 
 	httpTimeout := httpTimeout(timeout)
 
-	apiRequest, err := c.Get("/mappings", httpTimeout)
+	apiRequest, err := c.getAllCtx(ctx, "/mappings", nil, httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 
 	// Convert the API Response (map[string]interface{}) to a struct
 	var apiResponse GetHostMappingsResponse
-	mapErr := mapstructure.Decode(apiRequest, &apiResponse)
+	mapErr := decode(apiRequest, &apiResponse)
 	if mapErr != nil {
 		return nil, mapErr
 	}
@@ -371,66 +454,75 @@ func (c *Credentials) GetHostGroupMappings(timeout ...int) ([]IndividualHostMapp
 
 // DeleteHostGroupMappings removes all mappings from the provided Host Group.
 func (c *Credentials) DeleteHostGroupMappings(hostGroupName string, timeout ...int) (*DeleteResponse, error) {
+	return c.DeleteHostGroupMappingsCtx(context.Background(), hostGroupName, timeout...)
+}
+
+// DeleteHostGroupMappingsCtx is the context-aware form of DeleteHostGroupMappings.
+func (c *Credentials) DeleteHostGroupMappingsCtx(ctx context.Context, hostGroupName string, timeout ...int) (*DeleteResponse, error) {
 
 	httpTimeout := httpTimeout(timeout)
 
-	hostGroupID, err := c.GetHostGroupID(hostGroupName)
+	hostGroupID, err := c.GetHostGroupIDCtx(ctx, hostGroupName)
 	if hostGroupID != 0 && err != nil {
 		return nil, err
 	} else {
 
-		hostGroupMappingsOnServer, err := c.GetHostGroupMappings(httpTimeout)
+		hostGroupMappingsOnServer, err := c.GetHostGroupMappingsCtx(ctx, httpTimeout)
 		if err != nil {
 			return nil, err
 		}
-	
+
 		// Filter all "host_groups" mappings found on the server and save to a new
 		// slice for processing
 		var mappingIDs []int
 		for _, mapping := range hostGroupMappingsOnServer {
-			if mapping.Host.Ref == fmt.Sprintf("/host_groups/%d", hostGroupID) {
+			if mapping.Host == HostGroupRef(hostGroupID) {
 				mappingIDs = append(mappingIDs, mapping.ID)
 			}
-	
+
 		}
-	
+
 		// Return an error message if the host group does not have any mappings
 		for _, id := range mappingIDs {
-			_, err := c.Delete(fmt.Sprintf("/mappings/%d", id), httpTimeout)
+			_, err := c.DeleteCtx(ctx, fmt.Sprintf("/mappings/%d", id), httpTimeout)
 			if err != nil {
 				return nil, err
 			}
-	
+
 		}
-	
+
 		// Since we are ignoring the response of each of the Delete calls above,
 		// create a "dummy" DeleteReponse to return to the end user to signify success
 		var apiResponse DeleteResponse
 		apiResponse.StatusCode = 204
-	
+
 		return &apiResponse, nil
 
 	}
 }
 
-
 // DeleteHostGroupVolumeMapping removes a single Volume mapping from a Host Group.
 func (c *Credentials) DeleteHostGroupVolumeMapping(hostGroupName, volumeName string, timeout ...int) (*DeleteResponse, error) {
+	return c.DeleteHostGroupVolumeMappingCtx(context.Background(), hostGroupName, volumeName, timeout...)
+}
+
+// DeleteHostGroupVolumeMappingCtx is the context-aware form of DeleteHostGroupVolumeMapping.
+func (c *Credentials) DeleteHostGroupVolumeMappingCtx(ctx context.Context, hostGroupName, volumeName string, timeout ...int) (*DeleteResponse, error) {
 
 	httpTimeout := httpTimeout(timeout)
 
-	hostGroupID, err := c.GetHostGroupID(hostGroupName)
+	hostGroupID, err := c.GetHostGroupIDCtx(ctx, hostGroupName)
 	if hostGroupID != 0 && err != nil {
 		return nil, err
 	}
 
-	volumeID, err := c.GetVolumeID(volumeName)
+	volumeID, err := c.GetVolumeIDCtx(ctx, volumeName)
 	if volumeID != 0 && err != nil {
 		return nil, err
 	}
 
 	if volumeID != 0 && hostGroupID != 0 {
-		hostGroupMappingsOnServer, err := c.GetHostGroupMappings(httpTimeout)
+		hostGroupMappingsOnServer, err := c.GetHostGroupMappingsCtx(ctx, httpTimeout)
 		if err != nil {
 			return nil, err
 		}
@@ -438,8 +530,8 @@ func (c *Credentials) DeleteHostGroupVolumeMapping(hostGroupName, volumeName str
 		// results
 		mappingID := -1
 		for _, mapping := range hostGroupMappingsOnServer {
-			if mapping.Volume.Ref == fmt.Sprintf("/volumes/%d", volumeID) {
-				if mapping.Host.Ref == fmt.Sprintf("/host_groups/%d", hostGroupID) {
+			if mapping.Volume == VolumeRef(volumeID) {
+				if mapping.Host == HostGroupRef(hostGroupID) {
 					mappingID = mapping.ID
 
 				}
@@ -451,41 +543,46 @@ func (c *Credentials) DeleteHostGroupVolumeMapping(hostGroupName, volumeName str
 			return nil, fmt.Errorf("No %s Volume mappings found on the Host Group '%s'", volumeName, hostGroupName)
 		}
 
-		apiRequest, err := c.Delete(fmt.Sprintf("/mappings/%d", mappingID), httpTimeout)
+		apiRequest, err := c.DeleteCtx(ctx, fmt.Sprintf("/mappings/%d", mappingID), httpTimeout)
 		if err != nil {
 			return nil, err
 		}
 
 		// Convert the API Response (map[string]interface{}) to a struct
 		var apiResponse DeleteResponse
-		mapErr := mapstructure.Decode(apiRequest, &apiResponse)
+		mapErr := decode(apiRequest, &apiResponse)
 		if mapErr != nil {
 			return nil, mapErr
 		}
 
 		return &apiResponse, nil
 	}
-	return nil,nil
+	return nil, nil
 
 }
 
 // DeleteHostGroupVolumeGroupMapping removes a single Volume Group mapping from a Host Group.
 func (c *Credentials) DeleteHostGroupVolumeGroupMapping(hostGroupName, volumeGroupName string, timeout ...int) (*DeleteResponse, error) {
+	return c.DeleteHostGroupVolumeGroupMappingCtx(context.Background(), hostGroupName, volumeGroupName, timeout...)
+}
+
+// DeleteHostGroupVolumeGroupMappingCtx is the context-aware form of DeleteHostGroupVolumeGroupMapping.
+func (c *Credentials) DeleteHostGroupVolumeGroupMappingCtx(ctx context.Context, hostGroupName, volumeGroupName string, timeout ...int) (*DeleteResponse, error) {
 
 	httpTimeout := httpTimeout(timeout)
 
-	hostGroupID, err := c.GetHostGroupID(hostGroupName)
-	if hostGroupID != 0 && err != nil  {
+	hostGroupID, err := c.GetHostGroupIDCtx(ctx, hostGroupName)
+	if hostGroupID != 0 && err != nil {
 		return nil, err
 	}
 
-	volumeGroupID, err := c.GetVolumeGroupID(volumeGroupName)
-	if volumeGroupID != 0 && err != nil  {
+	volumeGroupID, err := c.GetVolumeGroupIDCtx(ctx, volumeGroupName)
+	if volumeGroupID != 0 && err != nil {
 		return nil, err
 	}
 
 	if volumeGroupID != 0 && hostGroupID != 0 {
-		hostGroupMappingsOnServer, err := c.GetHostGroupMappings(httpTimeout)
+		hostGroupMappingsOnServer, err := c.GetHostGroupMappingsCtx(ctx, httpTimeout)
 		if err != nil {
 			return nil, err
 		}
@@ -494,8 +591,8 @@ func (c *Credentials) DeleteHostGroupVolumeGroupMapping(hostGroupName, volumeGro
 		// results
 		mappingID := -1
 		for _, mapping := range hostGroupMappingsOnServer {
-			if mapping.Volume.Ref == fmt.Sprintf("/volume_groups/%d", volumeGroupID) {
-				if mapping.Host.Ref == fmt.Sprintf("/host_groups/%d", hostGroupID) {
+			if mapping.Volume == VolumeGroupRef(volumeGroupID) {
+				if mapping.Host == HostGroupRef(hostGroupID) {
 					mappingID = mapping.ID
 
 				}
@@ -507,34 +604,39 @@ func (c *Credentials) DeleteHostGroupVolumeGroupMapping(hostGroupName, volumeGro
 			return nil, fmt.Errorf("No %s Volume Group mappings found on the Host Group '%s'", volumeGroupName, hostGroupName)
 		}
 
-		apiRequest, err := c.Delete(fmt.Sprintf("/mappings/%d", mappingID), httpTimeout)
+		apiRequest, err := c.DeleteCtx(ctx, fmt.Sprintf("/mappings/%d", mappingID), httpTimeout)
 		if err != nil {
 			return nil, err
 		}
 
 		// Convert the API Response (map[string]interface{}) to a struct
 		var apiResponse DeleteResponse
-		mapErr := mapstructure.Decode(apiRequest, &apiResponse)
+		mapErr := decode(apiRequest, &apiResponse)
 		if mapErr != nil {
 			return nil, mapErr
 		}
 
 		return &apiResponse, nil
 	}
-	return nil,nil
+	return nil, nil
 }
 
 // GetHostGroupHosts provides the name of each Host in a Host Group.
 func (c *Credentials) GetHostGroupHosts(name string, timeout ...int) ([]string, error) {
+	return c.GetHostGroupHostsCtx(context.Background(), name, timeout...)
+}
+
+// GetHostGroupHostsCtx is the context-aware form of GetHostGroupHosts.
+func (c *Credentials) GetHostGroupHostsCtx(ctx context.Context, name string, timeout ...int) ([]string, error) {
 
 	httpTimeout := httpTimeout(timeout)
 
-	hostGroupID, err := c.GetHostGroupID(name, httpTimeout)
+	hostGroupID, err := c.GetHostGroupIDCtx(ctx, name, httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 
-	hostsOnServer, err := c.GetHosts(httpTimeout)
+	hostsOnServer, err := c.GetHostsCtx(ctx, httpTimeout)
 	if err != nil {
 		return nil, err
 	}
@@ -542,31 +644,35 @@ func (c *Credentials) GetHostGroupHosts(name string, timeout ...int) ([]string,
 	hostsInHostGroup := []string{}
 
 	for _, host := range hostsOnServer.Hits {
-		if host.HostGroup.Ref == fmt.Sprintf("/host_groups/%d", hostGroupID) {
+		if host.HostGroup == HostGroupRef(hostGroupID) {
 			hostsInHostGroup = append(hostsInHostGroup, host.Name)
 		}
 	}
 
 	return hostsInHostGroup, nil
 }
+
 // GetHostGroupByName returns information on all Host Groups found on the Silk server.
 func (c *Credentials) GetHostGroupByName(hostgroupname string, timeout ...int) (*GetHostGroupsResponse, error) {
+	return c.GetHostGroupByNameCtx(context.Background(), hostgroupname, timeout...)
+}
 
-	httpTimeout := httpTimeout(timeout)
+// GetHostGroupByNameCtx is the context-aware form of GetHostGroupByName.
+func (c *Credentials) GetHostGroupByNameCtx(ctx context.Context, hostgroupname string, timeout ...int) (*GetHostGroupsResponse, error) {
 
-	enduri := ("/host_groups?name__contains=" + hostgroupname)
+	httpTimeout := httpTimeout(timeout)
 
-	apiRequest, err := c.Get(enduri, httpTimeout)
+	apiRequest, err := c.getAllCtx(ctx, "/host_groups", Filter().Name().Contains(hostgroupname), httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 
 	// Convert the API Response (map[string]interface{}) to a struct
 	var apiResponse GetHostGroupsResponse
-	mapErr := mapstructure.Decode(apiRequest, &apiResponse)
+	mapErr := decode(apiRequest, &apiResponse)
 	if mapErr != nil {
 		return nil, mapErr
 	}
 
 	return &apiResponse, nil
-}
\ No newline at end of file
+}
@@ -0,0 +1,110 @@
+package silksdp
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// Limiter bounds the load a client puts on the Silk SDP management plane. It combines a token bucket, which caps the
+// number of requests started per second, with a semaphore capping the number of requests in flight at the same time.
+//
+// Every request made through a Credentials value, including the internal lookups done by helpers such as
+// CreateHostVolumeMapping(), goes through its Limiter. A single Limiter can be shared by several Credentials values
+// through WithLimiter() so they respect the same budget for a given array.
+type Limiter struct {
+	rate   float64
+	burst  float64
+	slots  chan struct{}
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+// NewLimiter returns a Limiter allowing requestsPerSecond requests per second with bursts of up to burst requests, and
+// at most maxInFlight concurrent requests. A requestsPerSecond or maxInFlight value of 0 disables the corresponding limit.
+// A burst lower than 1 is treated as 1.
+func NewLimiter(requestsPerSecond float64, burst, maxInFlight int) (*Limiter, error) {
+
+	if requestsPerSecond < 0 {
+		return nil, errors.New("The rate limit can not be negative")
+	}
+	if maxInFlight < 0 {
+		return nil, errors.New("The maximum number of requests in flight can not be negative")
+	}
+	if burst < 1 {
+		burst = 1
+	}
+
+	limiter := &Limiter{
+		rate:   requestsPerSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+	if maxInFlight > 0 {
+		limiter.slots = make(chan struct{}, maxInFlight)
+	}
+
+	return limiter, nil
+}
+
+// Wait blocks until the Limiter allows a new request or ctx is done. The returned function must be called once the
+// request has completed to free its in-flight slot.
+func (l *Limiter) Wait(ctx context.Context) (func(), error) {
+
+	if l == nil {
+		return func() {}, nil
+	}
+
+	if err := l.waitToken(ctx); err != nil {
+		return nil, err
+	}
+
+	if l.slots == nil {
+		return func() {}, nil
+	}
+
+	select {
+	case l.slots <- struct{}{}:
+		return func() { <-l.slots }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// waitToken takes a token from the bucket, waiting for one to become available when the bucket is empty.
+func (l *Limiter) waitToken(ctx context.Context) error {
+
+	if l.rate == 0 {
+		return nil
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+
+	// Reserve the token right away, the balance going negative queues the callers that follow
+	l.tokens--
+	wait := time.Duration(-l.tokens / l.rate * float64(time.Second))
+	l.mu.Unlock()
+
+	if wait <= 0 {
+		return nil
+	}
+
+	if err := sleepCtx(ctx, wait); err != nil {
+		// Hand the reservation back so a cancelled caller does not slow down the others
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+		return err
+	}
+
+	return nil
+}
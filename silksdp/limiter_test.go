@@ -0,0 +1,69 @@
+package silksdp
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func Test_LimiterMaxInFlight(t *testing.T) {
+	var inFlight, peak int32
+	silk := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		for {
+			previous := atomic.LoadInt32(&peak)
+			if current <= previous || atomic.CompareAndSwapInt32(&peak, previous, current) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		atomic.AddInt32(&inFlight, -1)
+		w.Write([]byte(`{"hits": []}`))
+	}, WithMaxInFlight(2))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := silk.Get("/hosts"); err != nil {
+				t.Errorf("Failed to fetch hosts: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if peak > 2 {
+		t.Errorf("Expected at most 2 requests in flight, got %d", peak)
+	}
+}
+
+func Test_LimiterRate(t *testing.T) {
+	limiter, err := NewLimiter(50, 1, 0)
+	if err != nil {
+		t.Fatalf("Failed to create limiter: %v", err)
+	}
+
+	start := time.Now()
+	for i := 0; i < 6; i++ {
+		release, err := limiter.Wait(context.Background())
+		if err != nil {
+			t.Fatalf("Failed to wait for the limiter: %v", err)
+		}
+		release()
+	}
+
+	// The first request uses the burst, the 5 that follow are spaced by 20ms
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Errorf("Expected the limiter to take at least 100ms, took %v", elapsed)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := limiter.Wait(ctx); err != context.Canceled {
+		t.Errorf("Expected context.Canceled, got: %v", err)
+	}
+}
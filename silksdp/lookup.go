@@ -0,0 +1,158 @@
+package silksdp
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// collections maps each ObjectKind to the API endpoint of its collection.
+var collections = map[ObjectKind]string{
+	KindHost:                "/hosts",
+	KindHostGroup:           "/host_groups",
+	KindVolume:              "/volumes",
+	KindVolumeGroup:         "/volume_groups",
+	KindVolumeGroupSnapshot: "/snapshots",
+	KindCapacityPolicy:      "/vg_capacity_policies",
+	KindRetentionPolicy:     "/retention_policies",
+	KindQoSPolicy:           "/qos_policies",
+	KindReplicationPeer:     "/replication/peer_k2arrays",
+	KindReplicationSession:  "/replication/sessions",
+}
+
+// maxIDsPerLookup bounds the number of IDs sent in a single id__in query to keep the URL short.
+const maxIDsPerLookup = 100
+
+// namedObject holds the fields shared by every Silk object and used to resolve names and IDs.
+type namedObject struct {
+	ID   int    `mapstructure:"id"`
+	Name string `mapstructure:"name"`
+}
+
+// collectionOf returns the API endpoint of the collection holding objects of the provided kind.
+func collectionOf(kind ObjectKind) (string, error) {
+	collection, ok := collections[kind]
+	if ok == false {
+		return "", fmt.Errorf("'%s' is not a supported object kind", kind)
+	}
+	return collection, nil
+}
+
+// lookupID returns the ID of the object of the provided kind and name through a name__in query, so only the matching
+// object is transferred instead of the whole collection.
+func (c *Credentials) lookupID(ctx context.Context, kind ObjectKind, name string, timeout int) (int, error) {
+
+	var object namedObject
+	if err := c.lookupObject(ctx, kind, name, &object, timeout); err != nil {
+		return 0, err
+	}
+
+	return object.ID, nil
+}
+
+// lookupObject decodes the object of the provided kind and name into output, fetching it through a name__in query.
+func (c *Credentials) lookupObject(ctx context.Context, kind ObjectKind, name string, output interface{}, timeout int) error {
+
+	collection, err := collectionOf(kind)
+	if err != nil {
+		return err
+	}
+
+	// name__in takes a comma separated list so a name containing a comma can only be found by scanning the collection
+	var filter ListFilter = Filter().Name().In(name)
+	if strings.Contains(name, ",") {
+		filter = nil
+	}
+
+	apiRequest, err := c.getAllCtx(ctx, collection, filter, timeout)
+	if err != nil {
+		return err
+	}
+
+	var apiResponse struct {
+		Hits []map[string]interface{} `mapstructure:"hits"`
+	}
+	mapErr := decode(apiRequest, &apiResponse)
+	if mapErr != nil {
+		return mapErr
+	}
+
+	for _, object := range apiResponse.Hits {
+		if object["name"] == name {
+			return decode(object, output)
+		}
+	}
+
+	return &NotFoundError{Kind: kind, Name: name}
+}
+
+// ResolveNames returns the name of every object of the provided kind whose ID is in ids, keyed by ID. The objects are
+// fetched through id__in queries of up to 100 IDs, so resolving the Hosts of many mappings takes a single request
+// instead of one full scan per mapping. A NotFoundError is returned if any ID does not exist on the Silk server.
+func (c *Credentials) ResolveNames(kind ObjectKind, ids []int, timeout ...int) (map[int]string, error) {
+	return c.ResolveNamesCtx(context.Background(), kind, ids, timeout...)
+}
+
+// ResolveNamesCtx is the context-aware form of ResolveNames.
+func (c *Credentials) ResolveNamesCtx(ctx context.Context, kind ObjectKind, ids []int, timeout ...int) (map[int]string, error) {
+	return c.resolveNames(ctx, kind, ids, httpTimeout(timeout))
+}
+
+// resolveNames is the implementation of ResolveNames() with an explicit request timeout.
+func (c *Credentials) resolveNames(ctx context.Context, kind ObjectKind, ids []int, timeout int) (map[int]string, error) {
+
+	collection, err := collectionOf(kind)
+	if err != nil {
+		return nil, err
+	}
+
+	// Remove duplicate IDs so each object is only requested once
+	unique := map[int]bool{}
+	for _, id := range ids {
+		unique[id] = true
+	}
+	sortedIDs := make([]int, 0, len(unique))
+	for id := range unique {
+		sortedIDs = append(sortedIDs, id)
+	}
+	sort.Ints(sortedIDs)
+
+	names := make(map[int]string, len(sortedIDs))
+	for start := 0; start < len(sortedIDs); start += maxIDsPerLookup {
+		end := start + maxIDsPerLookup
+		if end > len(sortedIDs) {
+			end = len(sortedIDs)
+		}
+
+		idList := make([]interface{}, 0, end-start)
+		for _, id := range sortedIDs[start:end] {
+			idList = append(idList, id)
+		}
+
+		apiRequest, err := c.getAllCtx(ctx, collection, Filter().ID().In(idList...), timeout)
+		if err != nil {
+			return nil, err
+		}
+
+		var apiResponse struct {
+			Hits []namedObject `mapstructure:"hits"`
+		}
+		mapErr := decode(apiRequest, &apiResponse)
+		if mapErr != nil {
+			return nil, mapErr
+		}
+
+		for _, object := range apiResponse.Hits {
+			names[object.ID] = object.Name
+		}
+	}
+
+	for _, id := range sortedIDs {
+		if _, ok := names[id]; ok == false {
+			return nil, &NotFoundError{Kind: kind, ID: id}
+		}
+	}
+
+	return names, nil
+}
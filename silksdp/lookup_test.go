@@ -0,0 +1,50 @@
+package silksdp
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func Test_LookupUsesServerSideFilters(t *testing.T) {
+	var queries []string
+	silk := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		query, _ := url.QueryUnescape(r.URL.RawQuery)
+		queries = append(queries, query)
+
+		hits := []map[string]interface{}{}
+		if name := r.URL.Query().Get("name__in"); name != "" {
+			hits = append(hits, map[string]interface{}{"id": 7, "name": name})
+		}
+		if ids := r.URL.Query().Get("id__in"); ids != "" {
+			for _, id := range strings.Split(ids, ",") {
+				number, _ := strconv.Atoi(id)
+				hits = append(hits, map[string]interface{}{"id": number, "name": "host-" + id})
+			}
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"hits": hits, "total": len(hits)})
+	})
+
+	hostID, err := silk.GetHostID("db01")
+	if err != nil || hostID != 7 {
+		t.Errorf("Expected the ID 7, got %d (%v)", hostID, err)
+	}
+	if len(queries) != 1 || strings.Contains(queries[0], "name__in=db01") == false {
+		t.Errorf("Expected a single name__in query, got %v", queries)
+	}
+
+	queries = nil
+	names, err := silk.ResolveNames(KindHost, []int{3, 1, 3, 2})
+	if err != nil {
+		t.Fatalf("Failed to resolve names: %v", err)
+	}
+	if len(names) != 3 || names[1] != "host-1" || names[3] != "host-3" {
+		t.Errorf("Unexpected names: %v", names)
+	}
+	if len(queries) != 1 || strings.Contains(queries[0], "id__in=1,2,3") == false {
+		t.Errorf("Expected a single id__in query, got %v", queries)
+	}
+}
@@ -0,0 +1,347 @@
+package silksdp
+
+import (
+	"context"
+)
+
+// defaultPageSize is the number of objects requested per page when paging through a collection.
+const defaultPageSize = 500
+
+// ListOptions controls how a collection is paged through by the IterateX() functions.
+type ListOptions struct {
+	// PageSize is the number of objects requested per API call. Defaults to 500.
+	PageSize int
+	// Offset skips the provided number of objects at the start of the collection.
+	Offset int
+	// Filter restricts the objects returned (ex: silksdp.Filter().Name().Contains("db")).
+	Filter ListFilter
+}
+
+// pager walks through the pages of a collection, one GET request per page.
+type pager struct {
+	c          *Credentials
+	ctx        context.Context
+	collection string
+	query      *Query
+	timeout    int
+	pageSize   int
+	offset     int
+	remaining  int
+	total      int
+	page       []interface{}
+	index      int
+	done       bool
+	err        error
+}
+
+// newPager returns a pager over the objects of the collection matching the provided filter. The Limit() and Offset()
+// of the filter bound the walk, ListOptions.Offset takes precedence over the filter offset.
+func (c *Credentials) newPager(ctx context.Context, collection string, filter ListFilter, opts *ListOptions, timeout int) *pager {
+
+	query := queryOf(filter)
+
+	// __offset paging needs a fixed order, otherwise the server may order two pages differently and objects are skipped
+	// or repeated. New objects get higher IDs, so they land on the last page instead of shifting the others.
+	if len(query.sort) == 0 {
+		sorted := *query
+		sorted.sort = []string{"id"}
+		query = &sorted
+	}
+
+	p := &pager{
+		c:          c,
+		ctx:        ctx,
+		collection: collection,
+		query:      query,
+		timeout:    timeout,
+		pageSize:   defaultPageSize,
+		offset:     query.offset,
+		remaining:  query.limit,
+	}
+	if opts != nil {
+		if opts.PageSize > 0 {
+			p.pageSize = opts.PageSize
+		}
+		if opts.Offset > 0 {
+			p.offset = opts.Offset
+		}
+	}
+
+	return p
+}
+
+// fetch requests the next page of the collection.
+func (p *pager) fetch() {
+
+	pageSize := p.pageSize
+	if p.query.limit > 0 && p.remaining < pageSize {
+		pageSize = p.remaining
+	}
+
+	apiRequest, err := p.c.getQueryCtx(p.ctx, p.collection, p.query.encode(pageSize, p.offset), p.timeout)
+	if err != nil {
+		p.err = err
+		return
+	}
+
+	var apiResponse struct {
+		Hits  []interface{} `mapstructure:"hits"`
+		Total *int          `mapstructure:"total"`
+	}
+	if mapErr := decode(apiRequest, &apiResponse); mapErr != nil {
+		p.err = mapErr
+		return
+	}
+
+	p.page = apiResponse.Hits
+	p.index = 0
+	p.offset += len(apiResponse.Hits)
+	p.remaining -= len(apiResponse.Hits)
+
+	// The server may cap __limit below the requested page size, so a short page only ends the walk when the server
+	// does not report the total. An empty page always ends it.
+	switch {
+	case len(apiResponse.Hits) == 0:
+		p.done = true
+	case apiResponse.Total != nil:
+		p.total = *apiResponse.Total
+		p.done = p.offset >= p.total
+	default:
+		p.done = len(apiResponse.Hits) < pageSize
+	}
+	if p.query.limit > 0 && p.remaining <= 0 {
+		p.done = true
+	}
+}
+
+// next returns the next raw object of the collection, fetching a new page when the current one is exhausted.
+func (p *pager) next() (interface{}, bool) {
+
+	for p.index >= len(p.page) {
+		if p.done || p.err != nil {
+			return nil, false
+		}
+		p.fetch()
+	}
+
+	hit := p.page[p.index]
+	p.index++
+	return hit, true
+}
+
+// getAllCtx fetches every page of the objects of the collection matching the filter and returns them as a single
+// response shaped like the response of one GET request, so it can be decoded into the GetXResponse types.
+func (c *Credentials) getAllCtx(ctx context.Context, collection string, filter ListFilter, timeout int) (interface{}, error) {
+
+	p := c.newPager(ctx, collection, filter, nil, timeout)
+
+	hits := []interface{}{}
+	for {
+		hit, ok := p.next()
+		if ok == false {
+			break
+		}
+		hits = append(hits, hit)
+	}
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	// Every object has been fetched, so the number of hits is the total when the server does not report it
+	total := p.total
+	if total == 0 {
+		total = len(hits)
+	}
+
+	return map[string]interface{}{
+		"hits":   hits,
+		"limit":  len(hits),
+		"offset": 0,
+		"total":  total,
+	}, nil
+}
+
+// VolumeIterator streams the Volumes of a Silk server page by page. It is returned by IterateVolumes().
+//
+//	it := silk.IterateVolumes(nil)
+//	for it.Next() {
+//		volume := it.Volume()
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type VolumeIterator struct {
+	pager   *pager
+	current Volume
+}
+
+// IterateVolumes returns an iterator over all Volumes found on the Silk server. Pages are only requested as the
+// iterator advances.
+func (c *Credentials) IterateVolumes(opts *ListOptions, timeout ...int) *VolumeIterator {
+	return c.IterateVolumesCtx(context.Background(), opts, timeout...)
+}
+
+// IterateVolumesCtx is the context-aware form of IterateVolumes. The context applies to every page request.
+func (c *Credentials) IterateVolumesCtx(ctx context.Context, opts *ListOptions, timeout ...int) *VolumeIterator {
+	return &VolumeIterator{pager: c.newPager(ctx, "/volumes", listFilter(opts), opts, httpTimeout(timeout))}
+}
+
+// Next advances the iterator and reports whether a Volume is available.
+func (it *VolumeIterator) Next() bool {
+	it.current = Volume{}
+	return nextHit(it.pager, &it.current)
+}
+
+// Volume returns the Volume the iterator currently points to.
+func (it *VolumeIterator) Volume() Volume {
+	return it.current
+}
+
+// Total returns the number of Volumes reported by the server. It is only known once Next() has been called.
+func (it *VolumeIterator) Total() int {
+	return it.pager.total
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *VolumeIterator) Err() error {
+	return it.pager.err
+}
+
+// VolumeGroupIterator streams the Volume Groups of a Silk server page by page. It is returned by IterateVolumeGroups().
+type VolumeGroupIterator struct {
+	pager   *pager
+	current VolumeGroup
+}
+
+// IterateVolumeGroups returns an iterator over all Volume Groups found on the Silk server. Pages are only requested as the
+// iterator advances.
+func (c *Credentials) IterateVolumeGroups(opts *ListOptions, timeout ...int) *VolumeGroupIterator {
+	return c.IterateVolumeGroupsCtx(context.Background(), opts, timeout...)
+}
+
+// IterateVolumeGroupsCtx is the context-aware form of IterateVolumeGroups. The context applies to every page request.
+func (c *Credentials) IterateVolumeGroupsCtx(ctx context.Context, opts *ListOptions, timeout ...int) *VolumeGroupIterator {
+	return &VolumeGroupIterator{pager: c.newPager(ctx, "/volume_groups", listFilter(opts), opts, httpTimeout(timeout))}
+}
+
+// Next advances the iterator and reports whether a Volume Group is available.
+func (it *VolumeGroupIterator) Next() bool {
+	it.current = VolumeGroup{}
+	return nextHit(it.pager, &it.current)
+}
+
+// VolumeGroup returns the Volume Group the iterator currently points to.
+func (it *VolumeGroupIterator) VolumeGroup() VolumeGroup {
+	return it.current
+}
+
+// Total returns the number of Volume Groups reported by the server. It is only known once Next() has been called.
+func (it *VolumeGroupIterator) Total() int {
+	return it.pager.total
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *VolumeGroupIterator) Err() error {
+	return it.pager.err
+}
+
+// HostIterator streams the Hosts of a Silk server page by page. It is returned by IterateHosts().
+type HostIterator struct {
+	pager   *pager
+	current Host
+}
+
+// IterateHosts returns an iterator over all Hosts found on the Silk server. Pages are only requested as the
+// iterator advances.
+func (c *Credentials) IterateHosts(opts *ListOptions, timeout ...int) *HostIterator {
+	return c.IterateHostsCtx(context.Background(), opts, timeout...)
+}
+
+// IterateHostsCtx is the context-aware form of IterateHosts. The context applies to every page request.
+func (c *Credentials) IterateHostsCtx(ctx context.Context, opts *ListOptions, timeout ...int) *HostIterator {
+	return &HostIterator{pager: c.newPager(ctx, "/hosts", listFilter(opts), opts, httpTimeout(timeout))}
+}
+
+// Next advances the iterator and reports whether a Host is available.
+func (it *HostIterator) Next() bool {
+	it.current = Host{}
+	return nextHit(it.pager, &it.current)
+}
+
+// Host returns the Host the iterator currently points to.
+func (it *HostIterator) Host() Host {
+	return it.current
+}
+
+// Total returns the number of Hosts reported by the server. It is only known once Next() has been called.
+func (it *HostIterator) Total() int {
+	return it.pager.total
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *HostIterator) Err() error {
+	return it.pager.err
+}
+
+// HostGroupIterator streams the Host Groups of a Silk server page by page. It is returned by IterateHostGroups().
+type HostGroupIterator struct {
+	pager   *pager
+	current HostGroup
+}
+
+// IterateHostGroups returns an iterator over all Host Groups found on the Silk server. Pages are only requested as the
+// iterator advances.
+func (c *Credentials) IterateHostGroups(opts *ListOptions, timeout ...int) *HostGroupIterator {
+	return c.IterateHostGroupsCtx(context.Background(), opts, timeout...)
+}
+
+// IterateHostGroupsCtx is the context-aware form of IterateHostGroups. The context applies to every page request.
+func (c *Credentials) IterateHostGroupsCtx(ctx context.Context, opts *ListOptions, timeout ...int) *HostGroupIterator {
+	return &HostGroupIterator{pager: c.newPager(ctx, "/host_groups", listFilter(opts), opts, httpTimeout(timeout))}
+}
+
+// Next advances the iterator and reports whether a Host Group is available.
+func (it *HostGroupIterator) Next() bool {
+	it.current = HostGroup{}
+	return nextHit(it.pager, &it.current)
+}
+
+// HostGroup returns the Host Group the iterator currently points to.
+func (it *HostGroupIterator) HostGroup() HostGroup {
+	return it.current
+}
+
+// Total returns the number of Host Groups reported by the server. It is only known once Next() has been called.
+func (it *HostGroupIterator) Total() int {
+	return it.pager.total
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *HostGroupIterator) Err() error {
+	return it.pager.err
+}
+
+// listFilter returns the filter of the provided ListOptions, if any.
+func listFilter(opts *ListOptions) ListFilter {
+	if opts == nil {
+		return nil
+	}
+	return opts.Filter
+}
+
+// nextHit decodes the next object of the pager into output and reports whether one was available.
+func nextHit(p *pager, output interface{}) bool {
+
+	hit, ok := p.next()
+	if ok == false {
+		return false
+	}
+
+	if mapErr := decode(hit, output); mapErr != nil {
+		p.err = mapErr
+		p.done = true
+		return false
+	}
+
+	return true
+}
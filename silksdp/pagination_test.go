@@ -0,0 +1,111 @@
+package silksdp
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+// pagedHosts serves total Hosts through the __limit and __offset query parameters. The total is left out of the
+// responses when reportTotal is false, and __limit is capped to maxLimit when it is set.
+func pagedHosts(t *testing.T, total int, reportTotal bool, maxLimit int, requests *int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		if sort := r.URL.Query().Get("__sort"); sort != "id" {
+			t.Errorf("Expected the pages to be sorted by id, got '%s'", sort)
+		}
+		limit, _ := strconv.Atoi(r.URL.Query().Get("__limit"))
+		if maxLimit > 0 && limit > maxLimit {
+			limit = maxLimit
+		}
+		offset, _ := strconv.Atoi(r.URL.Query().Get("__offset"))
+
+		hits := []map[string]interface{}{}
+		for id := offset + 1; id <= total && id <= offset+limit; id++ {
+			hits = append(hits, map[string]interface{}{"id": id, "name": fmt.Sprintf("host-%d", id)})
+		}
+		response := map[string]interface{}{"hits": hits, "limit": limit, "offset": offset}
+		if reportTotal {
+			response["total"] = total
+		}
+		json.NewEncoder(w).Encode(response)
+	}
+}
+
+func Test_GetHostsPaginates(t *testing.T) {
+	var requests int
+	silk := newTestClient(t, pagedHosts(t, 1234, true, 0, &requests))
+
+	hosts, err := silk.GetHosts()
+	if err != nil {
+		t.Fatalf("Failed to fetch hosts: %v", err)
+	}
+	if len(hosts.Hits) != 1234 || hosts.Total != 1234 {
+		t.Errorf("Expected 1234 hosts, got %d (total %d)", len(hosts.Hits), hosts.Total)
+	}
+	if requests != 3 {
+		t.Errorf("Expected 3 requests, got %d", requests)
+	}
+	if hosts.Hits[1233].Name != "host-1234" {
+		t.Errorf("Expected the last host to be host-1234, got %s", hosts.Hits[1233].Name)
+	}
+}
+
+func Test_GetHostsPaginatesWithoutTotal(t *testing.T) {
+	var requests int
+	silk := newTestClient(t, pagedHosts(t, 1234, false, 0, &requests))
+
+	hosts, err := silk.GetHosts()
+	if err != nil {
+		t.Fatalf("Failed to fetch hosts: %v", err)
+	}
+	if len(hosts.Hits) != 1234 || hosts.Total != 1234 {
+		t.Errorf("Expected 1234 hosts, got %d (total %d)", len(hosts.Hits), hosts.Total)
+	}
+	// The third page is short, which ends the walk without a fourth request
+	if requests != 3 {
+		t.Errorf("Expected 3 requests, got %d", requests)
+	}
+}
+
+func Test_GetHostsPaginatesWithCappedLimit(t *testing.T) {
+	var requests int
+	silk := newTestClient(t, pagedHosts(t, 250, true, 100, &requests))
+
+	hosts, err := silk.GetHosts()
+	if err != nil {
+		t.Fatalf("Failed to fetch hosts: %v", err)
+	}
+	// Every page is shorter than the 500 hosts requested, the total keeps the walk going
+	if len(hosts.Hits) != 250 || hosts.Total != 250 {
+		t.Errorf("Expected 250 hosts, got %d (total %d)", len(hosts.Hits), hosts.Total)
+	}
+	if requests != 3 {
+		t.Errorf("Expected 3 requests, got %d", requests)
+	}
+}
+
+func Test_IterateHosts(t *testing.T) {
+	var requests int
+	silk := newTestClient(t, pagedHosts(t, 25, true, 0, &requests))
+
+	it := silk.IterateHosts(&ListOptions{PageSize: 10})
+	var count int
+	for it.Next() {
+		count++
+		if expected := fmt.Sprintf("host-%d", count); it.Host().Name != expected {
+			t.Errorf("Expected %s, got %s", expected, it.Host().Name)
+		}
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("Failed to iterate over hosts: %v", err)
+	}
+	if count != 25 || it.Total() != 25 {
+		t.Errorf("Expected 25 hosts, got %d (total %d)", count, it.Total())
+	}
+	if requests != 3 {
+		t.Errorf("Expected 3 requests, got %d", requests)
+	}
+}
@@ -0,0 +1,16 @@
+package silksdp
+
+// String returns a pointer to the provided string, for use in the optional fields of the Update structs.
+func String(s string) *string {
+	return &s
+}
+
+// Int returns a pointer to the provided int, for use in the optional fields of the Update structs.
+func Int(i int) *int {
+	return &i
+}
+
+// Bool returns a pointer to the provided bool, for use in the optional fields of the Update structs.
+func Bool(b bool) *bool {
+	return &b
+}
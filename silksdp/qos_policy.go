@@ -0,0 +1,320 @@
+package silksdp
+
+import (
+	"context"
+	"fmt"
+)
+
+// QoSLimits holds the IOPS and bandwidth settings of a QoS Policy. Bandwidths are in MB/s and a value of 0 is not
+// enforced.
+type QoSLimits struct {
+	// MaxIOPS caps the IOPS of each Volume or Volume Group using the policy.
+	MaxIOPS int
+	// MaxBandwidth caps the bandwidth of each Volume or Volume Group using the policy.
+	MaxBandwidth int
+	// MinIOPS guarantees IOPS to each Volume or Volume Group using the policy.
+	MinIOPS int
+	// MinBandwidth guarantees bandwidth to each Volume or Volume Group using the policy.
+	MinBandwidth int
+}
+
+// validate returns an error if a value is negative or a guarantee exceeds its limit.
+func (l QoSLimits) validate() error {
+	if l.MaxIOPS < 0 || l.MaxBandwidth < 0 || l.MinIOPS < 0 || l.MinBandwidth < 0 {
+		return fmt.Errorf("The IOPS and bandwidth of a QoS Policy can not be negative")
+	}
+	if l.MaxIOPS != 0 && l.MinIOPS > l.MaxIOPS {
+		return fmt.Errorf("The guaranteed IOPS (%d) of a QoS Policy can not exceed its maximum IOPS (%d)", l.MinIOPS, l.MaxIOPS)
+	}
+	if l.MaxBandwidth != 0 && l.MinBandwidth > l.MaxBandwidth {
+		return fmt.Errorf("The guaranteed bandwidth (%d MB/s) of a QoS Policy can not exceed its maximum bandwidth (%d MB/s)", l.MinBandwidth, l.MaxBandwidth)
+	}
+	return nil
+}
+
+// GetQoSPolicy returns information on all QoS Policies found on the Silk server.
+func (c *Credentials) GetQoSPolicy(timeout ...int) (*GetQoSPolicyResponse, error) {
+	return c.GetQoSPolicyCtx(context.Background(), timeout...)
+}
+
+// GetQoSPolicyCtx is the context-aware form of GetQoSPolicy.
+func (c *Credentials) GetQoSPolicyCtx(ctx context.Context, timeout ...int) (*GetQoSPolicyResponse, error) {
+	httpTimeout := httpTimeout(timeout)
+
+	apiRequest, err := c.getAllCtx(ctx, "/qos_policies", nil, httpTimeout)
+	if err != nil {
+		return nil, err
+	}
+	// Convert the API Response (map[string]interface{}) to a struct
+	var apiResponse GetQoSPolicyResponse
+	mapErr := decode(apiRequest, &apiResponse)
+	if mapErr != nil {
+		return nil, mapErr
+	}
+
+	return &apiResponse, nil
+}
+
+// FindQoSPolicies returns the QoS Policies matching the provided filter, fetching every page of the results (ex:
+// silksdp.Filter().Name().Contains("gold")).
+func (c *Credentials) FindQoSPolicies(filter ListFilter, timeout ...int) (*GetQoSPolicyResponse, error) {
+	return c.FindQoSPoliciesCtx(context.Background(), filter, timeout...)
+}
+
+// FindQoSPoliciesCtx is the context-aware form of FindQoSPolicies.
+func (c *Credentials) FindQoSPoliciesCtx(ctx context.Context, filter ListFilter, timeout ...int) (*GetQoSPolicyResponse, error) {
+
+	apiRequest, err := c.getAllCtx(ctx, "/qos_policies", filter, httpTimeout(timeout))
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert the API Response (map[string]interface{}) to a struct
+	var apiResponse GetQoSPolicyResponse
+	mapErr := decode(apiRequest, &apiResponse)
+	if mapErr != nil {
+		return nil, mapErr
+	}
+
+	return &apiResponse, nil
+}
+
+// GetQoSPolicyID collects the QoS Policy ID
+func (c *Credentials) GetQoSPolicyID(name string, timeout ...int) (int, error) {
+	return c.GetQoSPolicyIDCtx(context.Background(), name, timeout...)
+}
+
+// GetQoSPolicyIDCtx is the context-aware form of GetQoSPolicyID.
+func (c *Credentials) GetQoSPolicyIDCtx(ctx context.Context, name string, timeout ...int) (int, error) {
+
+	httpTimeout := httpTimeout(timeout)
+
+	return c.lookupID(ctx, KindQoSPolicy, name, httpTimeout)
+}
+
+// GetQoSPolicyName returns the name of the QoS Policy based on the provided QoS Policy id.
+func (c *Credentials) GetQoSPolicyName(id int, timeout ...int) (string, error) {
+	return c.GetQoSPolicyNameCtx(context.Background(), id, timeout...)
+}
+
+// GetQoSPolicyNameCtx is the context-aware form of GetQoSPolicyName.
+func (c *Credentials) GetQoSPolicyNameCtx(ctx context.Context, id int, timeout ...int) (string, error) {
+
+	httpTimeout := httpTimeout(timeout)
+
+	names, err := c.resolveNames(ctx, KindQoSPolicy, []int{id}, httpTimeout)
+	if err != nil {
+		return "", err
+	}
+
+	return names[id], nil
+}
+
+// CreateQoSPolicy creates a new QoS Policy on the Silk server.
+func (c *Credentials) CreateQoSPolicy(name string, limits QoSLimits, timeout ...int) (*CreateOrUpdateQoSPolicyResponse, error) {
+	return c.CreateQoSPolicyCtx(context.Background(), name, limits, timeout...)
+}
+
+// CreateQoSPolicyCtx is the context-aware form of CreateQoSPolicy.
+func (c *Credentials) CreateQoSPolicyCtx(ctx context.Context, name string, limits QoSLimits, timeout ...int) (*CreateOrUpdateQoSPolicyResponse, error) {
+
+	httpTimeout := httpTimeout(timeout)
+
+	if err := limits.validate(); err != nil {
+		return nil, err
+	}
+
+	config := map[string]interface{}{}
+	config["name"] = name
+	config["max_iops"] = limits.MaxIOPS
+	config["max_bw"] = limits.MaxBandwidth
+	config["min_iops"] = limits.MinIOPS
+	config["min_bw"] = limits.MinBandwidth
+
+	apiRequest, err := c.PostCtx(ctx, "/qos_policies", config, httpTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	var apiResponse CreateOrUpdateQoSPolicyResponse
+	mapErr := decode(apiRequest, &apiResponse)
+	if mapErr != nil {
+		return nil, mapErr
+	}
+
+	return &apiResponse, nil
+}
+
+// QoSPolicyUpdate holds the changes applied to a QoS Policy by UpdateQoSPolicy(). Only the fields that are set are sent
+// to the Silk server. Bandwidths are in MB/s and a value of 0 removes the limit or guarantee.
+type QoSPolicyUpdate struct {
+	// Name renames the QoS Policy.
+	Name *string
+	// MaxIOPS changes the IOPS limit.
+	MaxIOPS *int
+	// MaxBandwidth changes the bandwidth limit.
+	MaxBandwidth *int
+	// MinIOPS changes the guaranteed IOPS.
+	MinIOPS *int
+	// MinBandwidth changes the guaranteed bandwidth.
+	MinBandwidth *int
+}
+
+// UpdateQoSPolicy applies the provided changes to a QoS Policy.
+func (c *Credentials) UpdateQoSPolicy(name string, update QoSPolicyUpdate, timeout ...int) (*CreateOrUpdateQoSPolicyResponse, error) {
+	return c.UpdateQoSPolicyCtx(context.Background(), name, update, timeout...)
+}
+
+// UpdateQoSPolicyCtx is the context-aware form of UpdateQoSPolicy.
+func (c *Credentials) UpdateQoSPolicyCtx(ctx context.Context, name string, update QoSPolicyUpdate, timeout ...int) (*CreateOrUpdateQoSPolicyResponse, error) {
+
+	httpTimeout := httpTimeout(timeout)
+
+	// Only the values being changed can be checked, the Silk server validates them against the current ones
+	var limits QoSLimits
+	config := map[string]interface{}{}
+	if update.Name != nil {
+		config["name"] = *update.Name
+	}
+	if update.MaxIOPS != nil {
+		limits.MaxIOPS = *update.MaxIOPS
+		config["max_iops"] = *update.MaxIOPS
+	}
+	if update.MaxBandwidth != nil {
+		limits.MaxBandwidth = *update.MaxBandwidth
+		config["max_bw"] = *update.MaxBandwidth
+	}
+	if update.MinIOPS != nil {
+		limits.MinIOPS = *update.MinIOPS
+		config["min_iops"] = *update.MinIOPS
+	}
+	if update.MinBandwidth != nil {
+		limits.MinBandwidth = *update.MinBandwidth
+		config["min_bw"] = *update.MinBandwidth
+	}
+	if len(config) == 0 {
+		return nil, errNoChanges
+	}
+	if err := limits.validate(); err != nil {
+		return nil, err
+	}
+
+	qosPolicyID, err := c.GetQoSPolicyIDCtx(ctx, name, httpTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	apiRequest, err := c.PatchCtx(ctx, fmt.Sprintf("/qos_policies/%d", qosPolicyID), config, httpTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert the API Response (map[string]interface{}) to a struct
+	var apiResponse CreateOrUpdateQoSPolicyResponse
+	mapErr := decode(apiRequest, &apiResponse)
+	if mapErr != nil {
+		return nil, mapErr
+	}
+
+	return &apiResponse, nil
+}
+
+// DeleteQoSPolicy deletes a QoS Policy from the Silk server.
+func (c *Credentials) DeleteQoSPolicy(name string, timeout ...int) (*DeleteResponse, error) {
+	return c.DeleteQoSPolicyCtx(context.Background(), name, timeout...)
+}
+
+// DeleteQoSPolicyCtx is the context-aware form of DeleteQoSPolicy.
+func (c *Credentials) DeleteQoSPolicyCtx(ctx context.Context, name string, timeout ...int) (*DeleteResponse, error) {
+
+	httpTimeout := httpTimeout(timeout)
+
+	qosPolicyID, err := c.GetQoSPolicyIDCtx(ctx, name, httpTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	apiRequest, err := c.DeleteCtx(ctx, fmt.Sprintf("/qos_policies/%d", qosPolicyID), httpTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert the API Response (map[string]interface{}) to a struct
+	var apiResponse DeleteResponse
+	mapErr := decode(apiRequest, &apiResponse)
+	if mapErr != nil {
+		return nil, mapErr
+	}
+
+	return &apiResponse, nil
+}
+
+// SetVolumeQoSPolicy attaches the QoS Policy with the provided name to a Volume. An empty policyName detaches the
+// current QoS Policy of the Volume.
+func (c *Credentials) SetVolumeQoSPolicy(volumeName, policyName string, timeout ...int) (*CreateOrUpdateVolumeResponse, error) {
+	return c.SetVolumeQoSPolicyCtx(context.Background(), volumeName, policyName, timeout...)
+}
+
+// SetVolumeQoSPolicyCtx is the context-aware form of SetVolumeQoSPolicy.
+func (c *Credentials) SetVolumeQoSPolicyCtx(ctx context.Context, volumeName, policyName string, timeout ...int) (*CreateOrUpdateVolumeResponse, error) {
+
+	httpTimeout := httpTimeout(timeout)
+
+	volumeID, err := c.GetVolumeIDCtx(ctx, volumeName, httpTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	var apiResponse CreateOrUpdateVolumeResponse
+	if err := c.setQoSPolicy(ctx, fmt.Sprintf("/volumes/%d", volumeID), policyName, &apiResponse, httpTimeout); err != nil {
+		return nil, err
+	}
+
+	return &apiResponse, nil
+}
+
+// SetVolumeGroupQoSPolicy attaches the QoS Policy with the provided name to a Volume Group. An empty policyName
+// detaches the current QoS Policy of the Volume Group.
+func (c *Credentials) SetVolumeGroupQoSPolicy(volumeGroupName, policyName string, timeout ...int) (*CreateOrUpdateVolumeGroupResponse, error) {
+	return c.SetVolumeGroupQoSPolicyCtx(context.Background(), volumeGroupName, policyName, timeout...)
+}
+
+// SetVolumeGroupQoSPolicyCtx is the context-aware form of SetVolumeGroupQoSPolicy.
+func (c *Credentials) SetVolumeGroupQoSPolicyCtx(ctx context.Context, volumeGroupName, policyName string, timeout ...int) (*CreateOrUpdateVolumeGroupResponse, error) {
+
+	httpTimeout := httpTimeout(timeout)
+
+	volumeGroupID, err := c.GetVolumeGroupIDCtx(ctx, volumeGroupName, httpTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	var apiResponse CreateOrUpdateVolumeGroupResponse
+	if err := c.setQoSPolicy(ctx, fmt.Sprintf("/volume_groups/%d", volumeGroupID), policyName, &apiResponse, httpTimeout); err != nil {
+		return nil, err
+	}
+
+	return &apiResponse, nil
+}
+
+// setQoSPolicy sets the qos_policy field of the object at the provided path and decodes the response into output.
+func (c *Credentials) setQoSPolicy(ctx context.Context, path, policyName string, output interface{}, httpTimeout int) error {
+
+	config := map[string]interface{}{}
+	config["qos_policy"] = nil
+	if policyName != "" {
+		qosPolicyID, err := c.GetQoSPolicyIDCtx(ctx, policyName, httpTimeout)
+		if err != nil {
+			return err
+		}
+		config["qos_policy"] = QoSPolicyRef(qosPolicyID)
+	}
+
+	apiRequest, err := c.PatchCtx(ctx, path, config, httpTimeout)
+	if err != nil {
+		return err
+	}
+
+	// Convert the API Response (map[string]interface{}) to a struct
+	return decode(apiRequest, output)
+}
@@ -0,0 +1,80 @@
+package silksdp
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func Test_CreateQoSPolicy(t *testing.T) {
+	var updated map[string]interface{}
+	silk := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/api/v2/qos_policies":
+			body := map[string]interface{}{}
+			json.NewDecoder(r.Body).Decode(&body)
+			json.NewEncoder(w).Encode(body)
+		case r.Method == "PATCH" && r.URL.Path == "/api/v2/qos_policies/6":
+			json.NewDecoder(r.Body).Decode(&updated)
+			json.NewEncoder(w).Encode(updated)
+		case r.Method == "GET" && r.URL.Path == "/api/v2/qos_policies":
+			json.NewEncoder(w).Encode(map[string]interface{}{"hits": []interface{}{map[string]interface{}{"id": 6, "name": "gold", "max_iops": 50000, "max_bw": 800}}, "total": 1})
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL)
+		}
+	})
+
+	if _, err := silk.CreateQoSPolicy("silver", QoSLimits{MaxIOPS: 1000, MinIOPS: 2000}); err == nil {
+		t.Errorf("Expected a guarantee above the limit to be refused")
+	}
+
+	policy, err := silk.CreateQoSPolicy("silver", QoSLimits{MaxIOPS: 20000, MaxBandwidth: 400, MinIOPS: 5000})
+	if err != nil {
+		t.Fatalf("Failed to create the QoS Policy: %v", err)
+	}
+	if policy.Name != "silver" || policy.MaxIOPS != 20000 || policy.MaxBandwidth != 400 || policy.MinIOPS != 5000 || policy.MinBandwidth != 0 {
+		t.Errorf("Unexpected QoS Policy: %+v", policy)
+	}
+
+	if _, err := silk.UpdateQoSPolicy("gold", QoSPolicyUpdate{MaxBandwidth: Int(1000)}); err != nil {
+		t.Fatalf("Failed to update the QoS Policy: %v", err)
+	}
+	if len(updated) != 1 || updated["max_bw"] != float64(1000) {
+		t.Errorf("Unexpected update body: %v", updated)
+	}
+}
+
+func Test_SetVolumeGroupQoSPolicy(t *testing.T) {
+	var updated map[string]interface{}
+	silk := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		hits := []interface{}{}
+		switch {
+		case r.Method == "PATCH" && r.URL.Path == "/api/v2/volume_groups/3":
+			json.NewDecoder(r.Body).Decode(&updated)
+			json.NewEncoder(w).Encode(updated)
+			return
+		case r.URL.Path == "/api/v2/qos_policies":
+			hits = append(hits, map[string]interface{}{"id": 6, "name": "gold"})
+		case r.URL.Path == "/api/v2/volume_groups":
+			hits = append(hits, map[string]interface{}{"id": 3, "name": "vg01"})
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL)
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"hits": hits, "total": len(hits)})
+	})
+
+	volumeGroup, err := silk.SetVolumeGroupQoSPolicy("vg01", "gold")
+	if err != nil {
+		t.Fatalf("Failed to attach the QoS Policy: %v", err)
+	}
+	if volumeGroup.QoSPolicy != QoSPolicyRef(6) {
+		t.Errorf("Unexpected QoS Policy reference: %v", volumeGroup.QoSPolicy)
+	}
+
+	if _, err := silk.SetVolumeGroupQoSPolicy("vg01", ""); err != nil {
+		t.Fatalf("Failed to detach the QoS Policy: %v", err)
+	}
+	if value, ok := updated["qos_policy"]; ok == false || value != nil {
+		t.Errorf("Expected the QoS Policy to be detached with null, got %v", updated)
+	}
+}
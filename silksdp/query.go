@@ -0,0 +1,198 @@
+package silksdp
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Query parameters understood by every Silk SDP collection endpoint.
+const (
+	queryLimit  = "__limit"
+	queryOffset = "__offset"
+	querySort   = "__sort"
+)
+
+// ListFilter is implemented by *Query and *FieldFilter so a filter can be passed to the FindX() and ListX() functions
+// at any point of its construction.
+type ListFilter interface {
+	query() *Query
+}
+
+// Query describes the filters, sort order, and bounds applied to a GET request against a Silk SDP collection. Values
+// are URL encoded when the query is sent, so names containing reserved characters are safe to use.
+//
+//	filter := silksdp.Filter().Name().Contains("db").Field("size").GreaterThan(silksdp.TiB(1)).Sort("-creation_time")
+//	volumes, err := silk.FindVolumes(filter)
+type Query struct {
+	conditions []queryCondition
+	limit      int
+	offset     int
+	sort       []string
+}
+
+// queryCondition is a single field__operator=value parameter.
+type queryCondition struct {
+	key   string
+	value string
+}
+
+// FieldFilter adds conditions on a single field of a Query. It embeds the Query so the chain can continue with another
+// field or with Limit() and Sort().
+type FieldFilter struct {
+	*Query
+	field string
+}
+
+// Filter returns a new, empty Query.
+func Filter() *Query {
+	return &Query{}
+}
+
+// query implements ListFilter. A nil *Query stands for an empty Query.
+func (q *Query) query() *Query {
+	return q
+}
+
+// Field starts a condition on the provided field (ex: "size", "volume_group").
+func (q *Query) Field(name string) *FieldFilter {
+	return &FieldFilter{Query: q, field: name}
+}
+
+// Name starts a condition on the name of the objects.
+func (q *Query) Name() *FieldFilter {
+	return q.Field("name")
+}
+
+// ID starts a condition on the ID of the objects.
+func (q *Query) ID() *FieldFilter {
+	return q.Field("id")
+}
+
+// Limit caps the total number of objects returned.
+func (q *Query) Limit(n int) *Query {
+	q.limit = n
+	return q
+}
+
+// Offset skips the provided number of objects at the start of the results.
+func (q *Query) Offset(n int) *Query {
+	q.offset = n
+	return q
+}
+
+// Sort orders the results by the provided fields. Prefix a field with '-' for a descending order
+// (ex: Sort("-creation_time")).
+func (q *Query) Sort(fields ...string) *Query {
+	q.sort = append(q.sort, fields...)
+	return q
+}
+
+// Encode returns the URL encoded query string, without the leading '?'.
+func (q *Query) Encode() string {
+	return q.encode(q.limit, q.offset)
+}
+
+// encode returns the URL encoded query string using the provided limit and offset instead of the ones of the Query. A
+// value of 0 omits the parameter.
+func (q *Query) encode(limit, offset int) string {
+
+	parameters := []string{}
+	for _, condition := range q.conditions {
+		parameters = append(parameters, url.QueryEscape(condition.key)+"="+condition.value)
+	}
+	if len(q.sort) != 0 {
+		parameters = append(parameters, querySort+"="+url.QueryEscape(strings.Join(q.sort, ",")))
+	}
+	if limit != 0 {
+		parameters = append(parameters, queryLimit+"="+strconv.Itoa(limit))
+	}
+	if offset != 0 {
+		parameters = append(parameters, queryOffset+"="+strconv.Itoa(offset))
+	}
+
+	return strings.Join(parameters, "&")
+}
+
+// add appends a condition on the field using the provided operator.
+func (f *FieldFilter) add(operator string, values ...interface{}) *FieldFilter {
+
+	encoded := make([]string, 0, len(values))
+	for _, value := range values {
+		// Capacities are compared by the Silk server in KiB rather than in their String() form
+		if capacity, ok := value.(Capacity); ok {
+			value = capacity.KiB()
+		}
+		encoded = append(encoded, url.QueryEscape(fmt.Sprint(value)))
+	}
+
+	key := f.field
+	if operator != "" {
+		key = fmt.Sprintf("%s__%s", f.field, operator)
+	}
+
+	f.conditions = append(f.conditions, queryCondition{key: key, value: strings.Join(encoded, ",")})
+	return f
+}
+
+// Equals matches objects whose field is equal to the value.
+func (f *FieldFilter) Equals(value interface{}) *FieldFilter {
+	return f.add("", value)
+}
+
+// In matches objects whose field is equal to one of the values.
+func (f *FieldFilter) In(values ...interface{}) *FieldFilter {
+	return f.add("in", values...)
+}
+
+// Contains matches objects whose field contains the provided substring.
+func (f *FieldFilter) Contains(value string) *FieldFilter {
+	return f.add("contains", value)
+}
+
+// GreaterThan matches objects whose field is strictly greater than the value.
+func (f *FieldFilter) GreaterThan(value interface{}) *FieldFilter {
+	return f.add("gt", value)
+}
+
+// GreaterThanOrEqual matches objects whose field is greater than or equal to the value.
+func (f *FieldFilter) GreaterThanOrEqual(value interface{}) *FieldFilter {
+	return f.add("gte", value)
+}
+
+// LessThan matches objects whose field is strictly lower than the value.
+func (f *FieldFilter) LessThan(value interface{}) *FieldFilter {
+	return f.add("lt", value)
+}
+
+// LessThanOrEqual matches objects whose field is lower than or equal to the value.
+func (f *FieldFilter) LessThanOrEqual(value interface{}) *FieldFilter {
+	return f.add("lte", value)
+}
+
+// query implements ListFilter. It is not promoted from the embedded Query so that a nil *FieldFilter does not panic.
+func (f *FieldFilter) query() *Query {
+	if f == nil {
+		return nil
+	}
+	return f.Query
+}
+
+// queryOf returns the Query behind a ListFilter, or an empty Query when filter is nil, including a typed nil.
+func queryOf(filter ListFilter) *Query {
+	if filter == nil {
+		return Filter()
+	}
+	if q := filter.query(); q != nil {
+		return q
+	}
+	return Filter()
+}
+
+// getQueryCtx sends a GET request to the collection with the provided, already encoded, query string. Unlike GetCtx()
+// the query is not escaped a second time.
+func (c *Credentials) getQueryCtx(ctx context.Context, collection, rawQuery string, timeout int) (interface{}, error) {
+	return c.makeHTTPCall(ctx, "GET", collection, rawQuery, nil, timeout)
+}
@@ -0,0 +1,41 @@
+package silksdp
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func Test_QueryEncode(t *testing.T) {
+	query := Filter().Name().In("db 01", "a&b").Contains("50%").Field("size").GreaterThan(1024).Sort("-creation_time").Limit(10)
+
+	expected := "name__in=db+01,a%26b&name__contains=50%25&size__gt=1024&__sort=-creation_time&__limit=10"
+	if encoded := query.Encode(); encoded != expected {
+		t.Errorf("Expected %s, got %s", expected, encoded)
+	}
+}
+
+func Test_FindHostsEncodesOnce(t *testing.T) {
+	var names []string
+	silk := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		names = append(names, r.URL.Query().Get("name__contains"))
+		json.NewEncoder(w).Encode(map[string]interface{}{"hits": []interface{}{}, "total": 0})
+	})
+
+	if _, err := silk.FindHosts(Filter().Name().Contains("50% & more")); err != nil {
+		t.Fatalf("Failed to find hosts: %v", err)
+	}
+	if len(names) != 1 || names[0] != "50% & more" {
+		t.Errorf("Expected the server to receive '50%% & more', got %v", names)
+	}
+}
+
+func Test_QueryOfTypedNil(t *testing.T) {
+	var fieldFilter *FieldFilter
+	var query *Query
+	for _, filter := range []ListFilter{nil, fieldFilter, query} {
+		if encoded := queryOf(filter).Encode(); encoded != "" {
+			t.Errorf("Expected an empty query for %#v, got %s", filter, encoded)
+		}
+	}
+}
@@ -0,0 +1,194 @@
+package silksdp
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Ref is a reference to another object on the Silk server, as found in API responses (ex: {"ref": "/hosts/12"}) and
+// expected in request bodies. The Ref field holds the raw path of the referenced object.
+type Ref struct {
+	Ref string `mapstructure:"ref" json:"ref"`
+}
+
+// NewRef returns a reference to the object of the provided kind and ID.
+func NewRef(kind ObjectKind, id int) Ref {
+	collection, ok := collections[kind]
+	if ok == false {
+		return Ref{}
+	}
+	return Ref{Ref: fmt.Sprintf("%s/%d", collection, id)}
+}
+
+// HostRef returns a reference to the Host with the provided ID.
+func HostRef(id int) Ref {
+	return NewRef(KindHost, id)
+}
+
+// HostGroupRef returns a reference to the Host Group with the provided ID.
+func HostGroupRef(id int) Ref {
+	return NewRef(KindHostGroup, id)
+}
+
+// VolumeRef returns a reference to the Volume with the provided ID.
+func VolumeRef(id int) Ref {
+	return NewRef(KindVolume, id)
+}
+
+// VolumeGroupRef returns a reference to the Volume Group with the provided ID.
+func VolumeGroupRef(id int) Ref {
+	return NewRef(KindVolumeGroup, id)
+}
+
+// SnapshotRef returns a reference to the Volume Group Snapshot with the provided ID.
+func SnapshotRef(id int) Ref {
+	return NewRef(KindVolumeGroupSnapshot, id)
+}
+
+// CapacityPolicyRef returns a reference to the Volume Group Capacity Policy with the provided ID.
+func CapacityPolicyRef(id int) Ref {
+	return NewRef(KindCapacityPolicy, id)
+}
+
+// RetentionPolicyRef returns a reference to the Retention Policy with the provided ID.
+func RetentionPolicyRef(id int) Ref {
+	return NewRef(KindRetentionPolicy, id)
+}
+
+// QoSPolicyRef returns a reference to the QoS Policy with the provided ID.
+func QoSPolicyRef(id int) Ref {
+	return NewRef(KindQoSPolicy, id)
+}
+
+// ReplicationPeerRef returns a reference to the Replication Peer Array with the provided ID.
+func ReplicationPeerRef(id int) Ref {
+	return NewRef(KindReplicationPeer, id)
+}
+
+// ReplicationSessionRef returns a reference to the Replication Session with the provided ID.
+func ReplicationSessionRef(id int) Ref {
+	return NewRef(KindReplicationSession, id)
+}
+
+// ParseRef parses a reference path such as "/hosts/12". An error is returned if the path does not end with a numeric ID.
+func ParseRef(path string) (Ref, error) {
+	ref := Ref{Ref: path}
+	if _, _, err := ref.parse(); err != nil {
+		return Ref{}, err
+	}
+	return ref, nil
+}
+
+// parse splits the reference into its collection and ID.
+func (r Ref) parse() (string, int, error) {
+	separator := strings.LastIndex(r.Ref, "/")
+	if separator <= 0 {
+		return "", 0, fmt.Errorf("'%s' is not a valid object reference", r.Ref)
+	}
+	id, err := strconv.Atoi(r.Ref[separator+1:])
+	if err != nil {
+		return "", 0, fmt.Errorf("'%s' is not a valid object reference", r.Ref)
+	}
+	return r.Ref[:separator], id, nil
+}
+
+// IsZero reports whether the reference is empty, which is how the Silk server reports an unset relation.
+func (r Ref) IsZero() bool {
+	return r.Ref == ""
+}
+
+// Kind returns the kind of the referenced object, or an empty ObjectKind when the collection is not known to the SDK.
+func (r Ref) Kind() ObjectKind {
+	collection, _, err := r.parse()
+	if err != nil {
+		return ""
+	}
+	for kind, kindCollection := range collections {
+		if kindCollection == collection {
+			return kind
+		}
+	}
+	return ""
+}
+
+// ID returns the ID of the referenced object, or 0 when the reference is empty or malformed.
+func (r Ref) ID() int {
+	_, id, err := r.parse()
+	if err != nil {
+		return 0
+	}
+	return id
+}
+
+// String implements the fmt.Stringer interface.
+func (r Ref) String() string {
+	return r.Ref
+}
+
+// Resolve fetches the object the reference points to. The returned value is a *Host, *HostGroup, *Volume,
+// *VolumeGroup, *VolumeGroupSnapshot, *CapacityPolicy, *RetentionPolicy, *QoSPolicy, *ReplicationPeerArray, or
+// *ReplicationSession depending on the Kind() of the reference. A NotFoundError is returned if the object no longer
+// exists.
+func (c *Credentials) Resolve(ref Ref, timeout ...int) (interface{}, error) {
+	return c.ResolveCtx(context.Background(), ref, timeout...)
+}
+
+// ResolveCtx is the context-aware form of Resolve.
+func (c *Credentials) ResolveCtx(ctx context.Context, ref Ref, timeout ...int) (interface{}, error) {
+
+	collection, id, err := ref.parse()
+	if err != nil {
+		return nil, err
+	}
+
+	var object interface{}
+	switch ref.Kind() {
+	case KindHost:
+		object = &Host{}
+	case KindHostGroup:
+		object = &HostGroup{}
+	case KindVolume:
+		object = &Volume{}
+	case KindVolumeGroup:
+		object = &VolumeGroup{}
+	case KindVolumeGroupSnapshot:
+		object = &VolumeGroupSnapshot{}
+	case KindCapacityPolicy:
+		object = &CapacityPolicy{}
+	case KindRetentionPolicy:
+		object = &RetentionPolicy{}
+	case KindQoSPolicy:
+		object = &QoSPolicy{}
+	case KindReplicationPeer:
+		object = &ReplicationPeerArray{}
+	case KindReplicationSession:
+		object = &ReplicationSession{}
+	default:
+		return nil, fmt.Errorf("The object reference '%s' can not be resolved", ref.Ref)
+	}
+
+	apiRequest, err := c.getAllCtx(ctx, collection, Filter().ID().In(id), httpTimeout(timeout))
+	if err != nil {
+		return nil, err
+	}
+
+	var apiResponse struct {
+		Hits []interface{} `mapstructure:"hits"`
+	}
+	mapErr := decode(apiRequest, &apiResponse)
+	if mapErr != nil {
+		return nil, mapErr
+	}
+	if len(apiResponse.Hits) == 0 {
+		return nil, &NotFoundError{Kind: ref.Kind(), ID: id}
+	}
+
+	mapErr = decode(apiResponse.Hits[0], object)
+	if mapErr != nil {
+		return nil, mapErr
+	}
+
+	return object, nil
+}
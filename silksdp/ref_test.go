@@ -0,0 +1,53 @@
+package silksdp
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+)
+
+func Test_Ref(t *testing.T) {
+	ref, err := ParseRef("/host_groups/12")
+	if err != nil {
+		t.Fatalf("Failed to parse the reference: %v", err)
+	}
+	if ref.Kind() != KindHostGroup || ref.ID() != 12 || ref != HostGroupRef(12) {
+		t.Errorf("Unexpected reference: kind %q, ID %d", ref.Kind(), ref.ID())
+	}
+
+	if _, err := ParseRef("/hosts/abc"); err == nil {
+		t.Errorf("Expected an error for a reference without a numeric ID")
+	}
+
+	encoded, _ := json.Marshal(map[string]interface{}{"host": HostRef(3)})
+	if string(encoded) != `{"host":{"ref":"/hosts/3"}}` {
+		t.Errorf("Unexpected JSON encoding: %s", encoded)
+	}
+
+	var mapping IndividualHostMappingResponse
+	if err := mapstructure.Decode(map[string]interface{}{"host": map[string]interface{}{"ref": "/hosts/3"}}, &mapping); err != nil {
+		t.Fatalf("Failed to decode the mapping: %v", err)
+	}
+	if mapping.Host.Kind() != KindHost || mapping.Host.ID() != 3 {
+		t.Errorf("Unexpected decoded reference: %s", mapping.Host)
+	}
+}
+
+func Test_Resolve(t *testing.T) {
+	silk := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/hosts" || r.URL.Query().Get("id__in") != "3" {
+			t.Errorf("Unexpected request: %s", r.URL)
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"hits": []interface{}{map[string]interface{}{"id": 3, "name": "db01"}}, "total": 1})
+	})
+
+	object, err := silk.Resolve(HostRef(3))
+	if err != nil {
+		t.Fatalf("Failed to resolve the reference: %v", err)
+	}
+	if host, ok := object.(*Host); ok == false || host.Name != "db01" {
+		t.Errorf("Expected the Host db01, got %#v", object)
+	}
+}
@@ -0,0 +1,478 @@
+package silksdp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ReplicationSessionState is the state of a Replication Session.
+type ReplicationSessionState string
+
+// States of a Replication Session. A session is created idle, replicates while running, and stops replicating when it
+// is suspended or failed over.
+const (
+	SessionIdle       ReplicationSessionState = "idle"
+	SessionRunning    ReplicationSessionState = "running"
+	SessionSuspended  ReplicationSessionState = "suspended"
+	SessionFailedOver ReplicationSessionState = "failed_over"
+)
+
+// ReplicationRole is the role of the local Silk server in a Replication Session.
+type ReplicationRole string
+
+// Roles of the local Silk server in a Replication Session.
+const (
+	RoleSource ReplicationRole = "source"
+	RoleTarget ReplicationRole = "target"
+)
+
+// RPOStatus compares the current RPO of a Replication Session with its target.
+type RPOStatus struct {
+	// Target is the RPO the session is configured to meet.
+	Target time.Duration
+	// Current is the age of the last snapshot replicated to the peer array.
+	Current time.Duration
+	// Breached is set when a running session does not meet its target RPO.
+	Breached bool
+}
+
+// RPOStatus returns the current RPO of the session compared with its target. Only running sessions can breach their
+// target RPO.
+func (s ReplicationSession) RPOStatus() RPOStatus {
+	return RPOStatus{
+		Target:   s.RPO,
+		Current:  s.CurrentRPO,
+		Breached: s.State == SessionRunning && s.CurrentRPO > s.RPO,
+	}
+}
+
+// GetReplicationPeerArrays returns information on all Replication Peer Arrays found on the Silk server.
+func (c *Credentials) GetReplicationPeerArrays(timeout ...int) (*GetReplicationPeerArraysResponse, error) {
+	return c.GetReplicationPeerArraysCtx(context.Background(), timeout...)
+}
+
+// GetReplicationPeerArraysCtx is the context-aware form of GetReplicationPeerArrays.
+func (c *Credentials) GetReplicationPeerArraysCtx(ctx context.Context, timeout ...int) (*GetReplicationPeerArraysResponse, error) {
+
+	apiRequest, err := c.getAllCtx(ctx, "/replication/peer_k2arrays", nil, httpTimeout(timeout))
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert the API Response (map[string]interface{}) to a struct
+	var apiResponse GetReplicationPeerArraysResponse
+	mapErr := decode(apiRequest, &apiResponse)
+	if mapErr != nil {
+		return nil, mapErr
+	}
+
+	return &apiResponse, nil
+}
+
+// ReplicationPeerArrayConfig holds the settings of CreateReplicationPeerArray().
+type ReplicationPeerArrayConfig struct {
+	// Name is the name of the peer array on the local Silk server.
+	Name string
+	// MgmtHost is the management address of the peer array.
+	MgmtHost string
+	// Username and Password are the credentials used to connect to the peer array.
+	Username string
+	Password string
+}
+
+// CreateReplicationPeerArray registers a remote Silk server as a Replication Peer Array.
+func (c *Credentials) CreateReplicationPeerArray(peer ReplicationPeerArrayConfig, timeout ...int) (*ReplicationPeerArray, error) {
+	return c.CreateReplicationPeerArrayCtx(context.Background(), peer, timeout...)
+}
+
+// CreateReplicationPeerArrayCtx is the context-aware form of CreateReplicationPeerArray.
+func (c *Credentials) CreateReplicationPeerArrayCtx(ctx context.Context, peer ReplicationPeerArrayConfig, timeout ...int) (*ReplicationPeerArray, error) {
+
+	if peer.Name == "" || peer.MgmtHost == "" {
+		return nil, errors.New("A Replication Peer Array requires a Name and a MgmtHost")
+	}
+
+	config := map[string]interface{}{}
+	config["name"] = peer.Name
+	config["mgmt_host"] = peer.MgmtHost
+	config["username"] = peer.Username
+	config["password"] = peer.Password
+
+	apiRequest, err := c.PostCtx(ctx, "/replication/peer_k2arrays", config, httpTimeout(timeout))
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert the API Response (map[string]interface{}) to a struct
+	var apiResponse ReplicationPeerArray
+	mapErr := decode(apiRequest, &apiResponse)
+	if mapErr != nil {
+		return nil, mapErr
+	}
+
+	return &apiResponse, nil
+}
+
+// ReplicationPeerArrayUpdate holds the changes applied to a Replication Peer Array by UpdateReplicationPeerArray().
+// Only the fields that are set are sent to the Silk server.
+type ReplicationPeerArrayUpdate struct {
+	// Name renames the peer array.
+	Name *string
+	// MgmtHost changes the management address of the peer array.
+	MgmtHost *string
+	// Username and Password change the credentials used to connect to the peer array.
+	Username *string
+	Password *string
+}
+
+// UpdateReplicationPeerArray applies the provided changes to a Replication Peer Array.
+func (c *Credentials) UpdateReplicationPeerArray(name string, update ReplicationPeerArrayUpdate, timeout ...int) (*ReplicationPeerArray, error) {
+	return c.UpdateReplicationPeerArrayCtx(context.Background(), name, update, timeout...)
+}
+
+// UpdateReplicationPeerArrayCtx is the context-aware form of UpdateReplicationPeerArray.
+func (c *Credentials) UpdateReplicationPeerArrayCtx(ctx context.Context, name string, update ReplicationPeerArrayUpdate, timeout ...int) (*ReplicationPeerArray, error) {
+
+	httpTimeout := httpTimeout(timeout)
+
+	config := map[string]interface{}{}
+	if update.Name != nil {
+		config["name"] = *update.Name
+	}
+	if update.MgmtHost != nil {
+		config["mgmt_host"] = *update.MgmtHost
+	}
+	if update.Username != nil {
+		config["username"] = *update.Username
+	}
+	if update.Password != nil {
+		config["password"] = *update.Password
+	}
+	if len(config) == 0 {
+		return nil, errNoChanges
+	}
+
+	peerID, err := c.lookupID(ctx, KindReplicationPeer, name, httpTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	apiRequest, err := c.PatchCtx(ctx, fmt.Sprintf("/replication/peer_k2arrays/%d", peerID), config, httpTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert the API Response (map[string]interface{}) to a struct
+	var apiResponse ReplicationPeerArray
+	mapErr := decode(apiRequest, &apiResponse)
+	if mapErr != nil {
+		return nil, mapErr
+	}
+
+	return &apiResponse, nil
+}
+
+// DeleteReplicationPeerArray removes a Replication Peer Array from the Silk server. The Silk server refuses to delete a
+// peer array that is still used by a Replication Session.
+func (c *Credentials) DeleteReplicationPeerArray(name string, timeout ...int) (*DeleteResponse, error) {
+	return c.DeleteReplicationPeerArrayCtx(context.Background(), name, timeout...)
+}
+
+// DeleteReplicationPeerArrayCtx is the context-aware form of DeleteReplicationPeerArray.
+func (c *Credentials) DeleteReplicationPeerArrayCtx(ctx context.Context, name string, timeout ...int) (*DeleteResponse, error) {
+
+	httpTimeout := httpTimeout(timeout)
+
+	peerID, err := c.lookupID(ctx, KindReplicationPeer, name, httpTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	apiRequest, err := c.DeleteCtx(ctx, fmt.Sprintf("/replication/peer_k2arrays/%d", peerID), httpTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert the API Response (map[string]interface{}) to a struct
+	var apiResponse DeleteResponse
+	mapErr := decode(apiRequest, &apiResponse)
+	if mapErr != nil {
+		return nil, mapErr
+	}
+
+	return &apiResponse, nil
+}
+
+// GetReplicationSessions returns information on all Replication Sessions found on the Silk server.
+func (c *Credentials) GetReplicationSessions(timeout ...int) (*GetReplicationSessionsResponse, error) {
+	return c.GetReplicationSessionsCtx(context.Background(), timeout...)
+}
+
+// GetReplicationSessionsCtx is the context-aware form of GetReplicationSessions.
+func (c *Credentials) GetReplicationSessionsCtx(ctx context.Context, timeout ...int) (*GetReplicationSessionsResponse, error) {
+
+	apiRequest, err := c.getAllCtx(ctx, "/replication/sessions", nil, httpTimeout(timeout))
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert the API Response (map[string]interface{}) to a struct
+	var apiResponse GetReplicationSessionsResponse
+	mapErr := decode(apiRequest, &apiResponse)
+	if mapErr != nil {
+		return nil, mapErr
+	}
+
+	return &apiResponse, nil
+}
+
+// GetReplicationSession returns the Replication Session with the provided name.
+func (c *Credentials) GetReplicationSession(name string, timeout ...int) (*ReplicationSession, error) {
+	return c.GetReplicationSessionCtx(context.Background(), name, timeout...)
+}
+
+// GetReplicationSessionCtx is the context-aware form of GetReplicationSession.
+func (c *Credentials) GetReplicationSessionCtx(ctx context.Context, name string, timeout ...int) (*ReplicationSession, error) {
+
+	var session ReplicationSession
+	if err := c.lookupObject(ctx, KindReplicationSession, name, &session, httpTimeout(timeout)); err != nil {
+		return nil, err
+	}
+
+	return &session, nil
+}
+
+// ReplicationSessionConfig holds the settings of CreateReplicationSession().
+type ReplicationSessionConfig struct {
+	// Name is the name of the session.
+	Name string
+	// VolumeGroup is the name of the local Volume Group to replicate.
+	VolumeGroup string
+	// PeerArray is the name of the Replication Peer Array to replicate to.
+	PeerArray string
+	// RetentionPolicy is the name of the Retention Policy of the replicated snapshots.
+	RetentionPolicy string
+	// RPO is the target Recovery Point Objective of the session. It is sent to the Silk server in whole seconds.
+	RPO time.Duration
+	// AutoConfigurePeerVolumes creates the Volume Group and Volumes on the peer array instead of expecting them to
+	// exist.
+	AutoConfigurePeerVolumes bool
+}
+
+// CreateReplicationSession creates an idle Replication Session. Use StartReplicationSession() to start replicating.
+func (c *Credentials) CreateReplicationSession(session ReplicationSessionConfig, timeout ...int) (*ReplicationSession, error) {
+	return c.CreateReplicationSessionCtx(context.Background(), session, timeout...)
+}
+
+// CreateReplicationSessionCtx is the context-aware form of CreateReplicationSession.
+func (c *Credentials) CreateReplicationSessionCtx(ctx context.Context, session ReplicationSessionConfig, timeout ...int) (*ReplicationSession, error) {
+
+	httpTimeout := httpTimeout(timeout)
+
+	if session.Name == "" || session.VolumeGroup == "" || session.PeerArray == "" || session.RetentionPolicy == "" {
+		return nil, errors.New("A Replication Session requires a Name, a VolumeGroup, a PeerArray, and a RetentionPolicy")
+	}
+	if session.RPO < time.Second {
+		return nil, fmt.Errorf("The RPO of the Replication Session '%s' must be at least one second", session.Name)
+	}
+
+	volumeGroupID, err := c.GetVolumeGroupIDCtx(ctx, session.VolumeGroup, httpTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	peerID, err := c.lookupID(ctx, KindReplicationPeer, session.PeerArray, httpTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	retentionPolicyID, err := c.GetRetentionPolicyIDCtx(ctx, session.RetentionPolicy, httpTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	config := map[string]interface{}{}
+	config["name"] = session.Name
+	config["local_volume_group"] = VolumeGroupRef(volumeGroupID)
+	config["replication_peer_k2array"] = ReplicationPeerRef(peerID)
+	config["retention_policy"] = RetentionPolicyRef(retentionPolicyID)
+	config["rpo"] = int(session.RPO / time.Second)
+	config["auto_configure_peer_volumes"] = session.AutoConfigurePeerVolumes
+
+	apiRequest, err := c.PostCtx(ctx, "/replication/sessions", config, httpTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert the API Response (map[string]interface{}) to a struct
+	var apiResponse ReplicationSession
+	mapErr := decode(apiRequest, &apiResponse)
+	if mapErr != nil {
+		return nil, mapErr
+	}
+
+	return &apiResponse, nil
+}
+
+// ReplicationSessionUpdate holds the changes applied to a Replication Session by UpdateReplicationSession(). Only the
+// fields that are set are sent to the Silk server.
+type ReplicationSessionUpdate struct {
+	// Name renames the session.
+	Name *string
+	// RPO changes the target RPO of the session.
+	RPO *time.Duration
+	// RetentionPolicy is the name of the new Retention Policy of the replicated snapshots.
+	RetentionPolicy *string
+}
+
+// UpdateReplicationSession applies the provided changes to a Replication Session.
+func (c *Credentials) UpdateReplicationSession(name string, update ReplicationSessionUpdate, timeout ...int) (*ReplicationSession, error) {
+	return c.UpdateReplicationSessionCtx(context.Background(), name, update, timeout...)
+}
+
+// UpdateReplicationSessionCtx is the context-aware form of UpdateReplicationSession.
+func (c *Credentials) UpdateReplicationSessionCtx(ctx context.Context, name string, update ReplicationSessionUpdate, timeout ...int) (*ReplicationSession, error) {
+
+	httpTimeout := httpTimeout(timeout)
+
+	config := map[string]interface{}{}
+	if update.Name != nil {
+		config["name"] = *update.Name
+	}
+	if update.RPO != nil {
+		if *update.RPO < time.Second {
+			return nil, fmt.Errorf("The RPO of the Replication Session '%s' must be at least one second", name)
+		}
+		config["rpo"] = int(*update.RPO / time.Second)
+	}
+	if update.RetentionPolicy != nil {
+		retentionPolicyID, err := c.GetRetentionPolicyIDCtx(ctx, *update.RetentionPolicy, httpTimeout)
+		if err != nil {
+			return nil, err
+		}
+		config["retention_policy"] = RetentionPolicyRef(retentionPolicyID)
+	}
+	if len(config) == 0 {
+		return nil, errNoChanges
+	}
+
+	sessionID, err := c.lookupID(ctx, KindReplicationSession, name, httpTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.patchReplicationSession(ctx, sessionID, config, httpTimeout)
+}
+
+// StartReplicationSession starts replicating an idle Replication Session.
+func (c *Credentials) StartReplicationSession(name string, timeout ...int) (*ReplicationSession, error) {
+	return c.StartReplicationSessionCtx(context.Background(), name, timeout...)
+}
+
+// StartReplicationSessionCtx is the context-aware form of StartReplicationSession.
+func (c *Credentials) StartReplicationSessionCtx(ctx context.Context, name string, timeout ...int) (*ReplicationSession, error) {
+	return c.setReplicationSessionState(ctx, name, SessionRunning, []ReplicationSessionState{SessionIdle}, httpTimeout(timeout))
+}
+
+// SuspendReplicationSession stops replicating a running Replication Session until it is resumed.
+func (c *Credentials) SuspendReplicationSession(name string, timeout ...int) (*ReplicationSession, error) {
+	return c.SuspendReplicationSessionCtx(context.Background(), name, timeout...)
+}
+
+// SuspendReplicationSessionCtx is the context-aware form of SuspendReplicationSession.
+func (c *Credentials) SuspendReplicationSessionCtx(ctx context.Context, name string, timeout ...int) (*ReplicationSession, error) {
+	return c.setReplicationSessionState(ctx, name, SessionSuspended, []ReplicationSessionState{SessionRunning}, httpTimeout(timeout))
+}
+
+// ResumeReplicationSession resumes replicating a suspended Replication Session.
+func (c *Credentials) ResumeReplicationSession(name string, timeout ...int) (*ReplicationSession, error) {
+	return c.ResumeReplicationSessionCtx(context.Background(), name, timeout...)
+}
+
+// ResumeReplicationSessionCtx is the context-aware form of ResumeReplicationSession.
+func (c *Credentials) ResumeReplicationSessionCtx(ctx context.Context, name string, timeout ...int) (*ReplicationSession, error) {
+	return c.setReplicationSessionState(ctx, name, SessionRunning, []ReplicationSessionState{SessionSuspended}, httpTimeout(timeout))
+}
+
+// FailoverReplicationSession fails a running or suspended Replication Session over to the peer array, which makes the
+// peer Volume Group writable. Replication stops until the session is reconfigured.
+func (c *Credentials) FailoverReplicationSession(name string, timeout ...int) (*ReplicationSession, error) {
+	return c.FailoverReplicationSessionCtx(context.Background(), name, timeout...)
+}
+
+// FailoverReplicationSessionCtx is the context-aware form of FailoverReplicationSession.
+func (c *Credentials) FailoverReplicationSessionCtx(ctx context.Context, name string, timeout ...int) (*ReplicationSession, error) {
+	return c.setReplicationSessionState(ctx, name, SessionFailedOver, []ReplicationSessionState{SessionRunning, SessionSuspended}, httpTimeout(timeout))
+}
+
+// DeleteReplicationSession deletes a Replication Session from the Silk server. The replicated Volume Groups are kept.
+func (c *Credentials) DeleteReplicationSession(name string, timeout ...int) (*DeleteResponse, error) {
+	return c.DeleteReplicationSessionCtx(context.Background(), name, timeout...)
+}
+
+// DeleteReplicationSessionCtx is the context-aware form of DeleteReplicationSession.
+func (c *Credentials) DeleteReplicationSessionCtx(ctx context.Context, name string, timeout ...int) (*DeleteResponse, error) {
+
+	httpTimeout := httpTimeout(timeout)
+
+	sessionID, err := c.lookupID(ctx, KindReplicationSession, name, httpTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	apiRequest, err := c.DeleteCtx(ctx, fmt.Sprintf("/replication/sessions/%d", sessionID), httpTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert the API Response (map[string]interface{}) to a struct
+	var apiResponse DeleteResponse
+	mapErr := decode(apiRequest, &apiResponse)
+	if mapErr != nil {
+		return nil, mapErr
+	}
+
+	return &apiResponse, nil
+}
+
+// setReplicationSessionState moves the Replication Session to the target state. An error is returned without
+// contacting the Silk server again if the session is not in one of the allowed states.
+func (c *Credentials) setReplicationSessionState(ctx context.Context, name string, target ReplicationSessionState, allowed []ReplicationSessionState, httpTimeout int) (*ReplicationSession, error) {
+
+	var session ReplicationSession
+	if err := c.lookupObject(ctx, KindReplicationSession, name, &session, httpTimeout); err != nil {
+		return nil, err
+	}
+
+	permitted := false
+	for _, state := range allowed {
+		if session.State == state {
+			permitted = true
+		}
+	}
+	if permitted == false {
+		return nil, fmt.Errorf("The Replication Session '%s' is %s and can not be moved to %s", name, session.State, target)
+	}
+
+	return c.patchReplicationSession(ctx, session.ID, map[string]interface{}{"state": target}, httpTimeout)
+}
+
+// patchReplicationSession sends the PATCH request shared by the Replication Session update functions.
+func (c *Credentials) patchReplicationSession(ctx context.Context, sessionID int, config map[string]interface{}, httpTimeout int) (*ReplicationSession, error) {
+
+	apiRequest, err := c.PatchCtx(ctx, fmt.Sprintf("/replication/sessions/%d", sessionID), config, httpTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert the API Response (map[string]interface{}) to a struct
+	var apiResponse ReplicationSession
+	mapErr := decode(apiRequest, &apiResponse)
+	if mapErr != nil {
+		return nil, mapErr
+	}
+
+	return &apiResponse, nil
+}
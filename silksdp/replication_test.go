@@ -0,0 +1,91 @@
+package silksdp
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func Test_CreateReplicationSession(t *testing.T) {
+	var created map[string]interface{}
+	silk := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		hits := []interface{}{}
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/api/v2/replication/sessions":
+			json.NewDecoder(r.Body).Decode(&created)
+			session := map[string]interface{}{"id": 4, "name": "dr01", "state": "idle", "local_volume_group": map[string]interface{}{"ref": "/volume_groups/3"}}
+			for key, value := range created {
+				session[key] = value
+			}
+			json.NewEncoder(w).Encode(session)
+			return
+		case r.URL.Path == "/api/v2/replication/peer_k2arrays":
+			hits = append(hits, map[string]interface{}{"id": 2, "name": "remote", "mgmt_host": "10.0.0.2"})
+		case r.URL.Path == "/api/v2/volume_groups":
+			hits = append(hits, map[string]interface{}{"id": 3, "name": "vg01"})
+		case r.URL.Path == "/api/v2/retention_policies":
+			hits = append(hits, map[string]interface{}{"id": 5, "name": "Best_Practice"})
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL)
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"hits": hits, "total": len(hits)})
+	})
+
+	session, err := silk.CreateReplicationSession(ReplicationSessionConfig{
+		Name:            "dr01",
+		VolumeGroup:     "vg01",
+		PeerArray:       "remote",
+		RetentionPolicy: "Best_Practice",
+		RPO:             10 * time.Minute,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create the replication session: %v", err)
+	}
+
+	if created["rpo"] != float64(600) || created["replication_peer_k2array"].(map[string]interface{})["ref"] != "/replication/peer_k2arrays/2" {
+		t.Errorf("Unexpected request body: %v", created)
+	}
+	if session.RPO != 10*time.Minute || session.LocalVolumeGroup != VolumeGroupRef(3) {
+		t.Errorf("Unexpected session: %+v", session)
+	}
+}
+
+func Test_ReplicationSessionState(t *testing.T) {
+	var patched map[string]interface{}
+	silk := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		session := map[string]interface{}{"id": 4, "name": "dr01", "state": "idle", "rpo": 300, "current_rpo": 420}
+		switch {
+		case r.Method == "PATCH" && r.URL.Path == "/api/v2/replication/sessions/4":
+			json.NewDecoder(r.Body).Decode(&patched)
+			for key, value := range patched {
+				session[key] = value
+			}
+			json.NewEncoder(w).Encode(session)
+		case r.Method == "GET" && r.URL.Path == "/api/v2/replication/sessions":
+			json.NewEncoder(w).Encode(map[string]interface{}{"hits": []interface{}{session}, "total": 1})
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL)
+		}
+	})
+
+	if _, err := silk.SuspendReplicationSession("dr01"); err == nil {
+		t.Errorf("Expected suspending an idle session to be refused")
+	}
+	if patched != nil {
+		t.Errorf("Expected the refused transition not to send a PATCH request")
+	}
+
+	session, err := silk.StartReplicationSession("dr01")
+	if err != nil {
+		t.Fatalf("Failed to start the replication session: %v", err)
+	}
+	if session.State != SessionRunning {
+		t.Errorf("Expected the session to be running, got %s", session.State)
+	}
+
+	status := session.RPOStatus()
+	if status.Target != 5*time.Minute || status.Current != 7*time.Minute || status.Breached == false {
+		t.Errorf("Unexpected RPO status: %+v", status)
+	}
+}
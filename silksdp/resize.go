@@ -0,0 +1,137 @@
+package silksdp
+
+import (
+	"context"
+	"fmt"
+)
+
+// ResizeOptions holds the optional settings of ResizeVolume().
+type ResizeOptions struct {
+	// Force allows the Volume to be shrunk. Shrinking a Volume destroys the data stored beyond its new size.
+	Force bool
+	// AllowOverProvisioning skips the Volume Group quota and Capacity Policy checks, for thinly provisioned Volume
+	// Groups whose Volumes are expected to exceed the quota.
+	AllowOverProvisioning bool
+}
+
+// ResizeResult holds the outcome of ResizeVolume().
+type ResizeResult struct {
+	// Volume is the Volume as returned by the Silk server after the resize.
+	Volume *Volume
+	// PreviousSize is the size of the Volume before the resize.
+	PreviousSize Capacity
+	// NewSize is the size of the Volume after the resize.
+	NewSize Capacity
+	// Warnings is set when the Volume Group reaches the warning threshold of its Capacity Policy after the resize.
+	Warnings []string
+}
+
+// ResizeVolume changes the size of a Volume after checking that the Volume Group can hold it. The resize is refused
+// when:
+//
+// - newSize is smaller than the current size and opts.Force is not set.
+//
+// - Growing the Volume would make the provisioned capacity of its Volume Group exceed the quota.
+//
+// - Growing the Volume would make the provisioned capacity of its Volume Group reach the error threshold of its
+// Capacity Policy.
+//
+// The quota and Capacity Policy checks are skipped when opts.AllowOverProvisioning is set. No request is sent when the
+// Volume already has the requested size.
+func (c *Credentials) ResizeVolume(name string, newSize Capacity, opts *ResizeOptions, timeout ...int) (*ResizeResult, error) {
+	return c.ResizeVolumeCtx(context.Background(), name, newSize, opts, timeout...)
+}
+
+// ResizeVolumeCtx is the context-aware form of ResizeVolume.
+func (c *Credentials) ResizeVolumeCtx(ctx context.Context, name string, newSize Capacity, opts *ResizeOptions, timeout ...int) (*ResizeResult, error) {
+
+	httpTimeout := httpTimeout(timeout)
+
+	if opts == nil {
+		opts = &ResizeOptions{}
+	}
+
+	if newSize <= 0 {
+		return nil, fmt.Errorf("The new size of the Volume must be greater than 0")
+	}
+
+	var volume Volume
+	if err := c.lookupObject(ctx, KindVolume, name, &volume, httpTimeout); err != nil {
+		return nil, err
+	}
+
+	result := &ResizeResult{Volume: &volume, PreviousSize: volume.Size, NewSize: newSize}
+	if newSize == volume.Size {
+		return result, nil
+	}
+
+	if newSize < volume.Size && opts.Force == false {
+		return nil, fmt.Errorf("The Volume '%s' can not be shrunk from %s to %s without setting Force", name, volume.Size, newSize)
+	}
+
+	if newSize > volume.Size && opts.AllowOverProvisioning == false {
+		warnings, err := c.checkVolumeGroupCapacity(ctx, volume, newSize, httpTimeout)
+		if err != nil {
+			return nil, err
+		}
+		result.Warnings = warnings
+	}
+
+	apiRequest, err := c.PatchCtx(ctx, fmt.Sprintf("/volumes/%d", volume.ID), map[string]interface{}{"size": newSize}, httpTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert the API Response (map[string]interface{}) to a struct
+	var apiResponse Volume
+	mapErr := decode(apiRequest, &apiResponse)
+	if mapErr != nil {
+		return nil, mapErr
+	}
+
+	result.Volume = &apiResponse
+	return result, nil
+}
+
+// checkVolumeGroupCapacity returns an error if resizing the volume to newSize would exceed the quota of its Volume Group
+// or reach the error threshold of its Capacity Policy. Reaching the warning threshold is returned as a warning.
+func (c *Credentials) checkVolumeGroupCapacity(ctx context.Context, volume Volume, newSize Capacity, httpTimeout int) ([]string, error) {
+
+	object, err := c.ResolveCtx(ctx, volume.VolumeGroup, httpTimeout)
+	if err != nil {
+		return nil, err
+	}
+	volumeGroup := object.(*VolumeGroup)
+
+	// Volume Groups without a quota can grow until the array is full
+	if volumeGroup.Quota == 0 {
+		return nil, nil
+	}
+
+	provisioned := volumeGroup.VolumesProvisionedCapacity - volume.Size + newSize
+	if provisioned > volumeGroup.Quota {
+		return nil, fmt.Errorf("Resizing the Volume '%s' to %s would provision %s in the Volume Group '%s', which exceeds its quota of %s", volume.Name, newSize, provisioned, volumeGroup.Name, volumeGroup.Quota)
+	}
+
+	if volumeGroup.CapacityPolicy.IsZero() {
+		return nil, nil
+	}
+
+	object, err = c.ResolveCtx(ctx, volumeGroup.CapacityPolicy, httpTimeout)
+	if err != nil {
+		return nil, err
+	}
+	capacityPolicy := object.(*CapacityPolicy)
+
+	percentage := int(provisioned * 100 / volumeGroup.Quota)
+	if capacityPolicy.ErrorThreshold > 0 && percentage >= capacityPolicy.ErrorThreshold {
+		return nil, fmt.Errorf("Resizing the Volume '%s' to %s would use %d%% of the quota of the Volume Group '%s', which reaches the %d%% error threshold of the Capacity Policy '%s'", volume.Name, newSize, percentage, volumeGroup.Name, capacityPolicy.ErrorThreshold, capacityPolicy.Name)
+	}
+
+	var warnings []string
+	if capacityPolicy.WarningThreshold > 0 && percentage >= capacityPolicy.WarningThreshold {
+		warnings = append(warnings, fmt.Sprintf("The Volume Group '%s' will use %d%% of its quota, which reaches the %d%% warning threshold of the Capacity Policy '%s'", volumeGroup.Name, percentage, capacityPolicy.WarningThreshold, capacityPolicy.Name))
+	}
+
+	return warnings, nil
+}
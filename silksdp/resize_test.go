@@ -0,0 +1,60 @@
+package silksdp
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func Test_ResizeVolume(t *testing.T) {
+	var patched bool
+	silk := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		hits := []interface{}{}
+		switch {
+		case r.Method == "PATCH" && r.URL.Path == "/api/v2/volumes/7":
+			patched = true
+			var body map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&body)
+			json.NewEncoder(w).Encode(map[string]interface{}{"id": 7, "name": "vol01", "size": body["size"]})
+			return
+		case r.URL.Path == "/api/v2/volumes":
+			hits = append(hits, map[string]interface{}{"id": 7, "name": "vol01", "size": GiB(100), "volume_group": map[string]interface{}{"ref": "/volume_groups/3"}})
+		case r.URL.Path == "/api/v2/volume_groups":
+			hits = append(hits, map[string]interface{}{"id": 3, "name": "vg01", "quota": TiB(1), "volumes_provisioned_capacity": GiB(600), "capacity_policy": map[string]interface{}{"ref": "/vg_capacity_policies/2"}})
+		case r.URL.Path == "/api/v2/vg_capacity_policies":
+			hits = append(hits, map[string]interface{}{"id": 2, "name": "default", "warning_threshold": 70, "error_threshold": 90})
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL)
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"hits": hits, "total": len(hits)})
+	})
+
+	// 600GiB - 100GiB + 300GiB = 800GiB, which reaches the 70% warning threshold of the 1TiB quota
+	result, err := silk.ResizeVolume("vol01", GiB(300), nil)
+	if err != nil {
+		t.Fatalf("Failed to resize the volume: %v", err)
+	}
+	if result.PreviousSize != GiB(100) || result.NewSize != GiB(300) || result.Volume.Size != GiB(300) || len(result.Warnings) != 1 {
+		t.Errorf("Unexpected result: %+v", result)
+	}
+
+	patched = false
+	// 600GiB - 100GiB + 500GiB = 1000GiB, which reaches the 90% error threshold
+	if _, err := silk.ResizeVolume("vol01", GiB(500), nil); err == nil || strings.Contains(err.Error(), "error threshold") == false {
+		t.Errorf("Expected the error threshold to refuse the resize, got %v", err)
+	}
+	if _, err := silk.ResizeVolume("vol01", GiB(600), nil); err == nil || strings.Contains(err.Error(), "quota") == false {
+		t.Errorf("Expected the quota to refuse the resize, got %v", err)
+	}
+	if _, err := silk.ResizeVolume("vol01", GiB(50), nil); err == nil {
+		t.Errorf("Expected shrinking without Force to be refused")
+	}
+	if patched {
+		t.Errorf("Expected the refused resizes not to send a PATCH request")
+	}
+
+	if _, err := silk.ResizeVolume("vol01", GiB(50), &ResizeOptions{Force: true}); err != nil || patched == false {
+		t.Errorf("Expected the forced shrink to be sent, got %v", err)
+	}
+}
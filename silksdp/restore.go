@@ -0,0 +1,228 @@
+package silksdp
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// RestoreOptions holds the optional settings of RestoreVolumeGroupFromSnapshot().
+type RestoreOptions struct {
+	// Force restores the Volume Group even when Hosts or Host Groups are mapped to it. Mapped Hosts see the content of
+	// their Volumes change underneath them, so they should be quiesced first. Without Force, a mapped Volume Group is
+	// not restored and a MappedVolumeGroupError is returned along with the mapped Hosts and Host Groups.
+	Force bool
+	// SafetySnapshot is the name of a snapshot of the Volume Group taken right before the restore, so the current
+	// content can be recovered. No snapshot is taken when empty.
+	SafetySnapshot string
+	// SafetyRetentionPolicy is the name of the Retention Policy of the safety snapshot. The Retention Policy of the
+	// restored snapshot is used when empty.
+	SafetyRetentionPolicy string
+}
+
+// RestoreResult holds the outcome of RestoreVolumeGroupFromSnapshot().
+type RestoreResult struct {
+	// VolumeGroup is the Volume Group as returned by the Silk server once the restore is confirmed.
+	VolumeGroup *VolumeGroup
+	// MappedHosts holds the names of the Hosts mapped to the Volume Group, or to one of its Volumes, at the time of the
+	// restore.
+	MappedHosts []string
+	// MappedHostGroups holds the names of the Host Groups mapped to the Volume Group, or to one of its Volumes, at the
+	// time of the restore.
+	MappedHostGroups []string
+	// SafetySnapshot is the snapshot taken before the restore when RestoreOptions.SafetySnapshot is set.
+	SafetySnapshot *VolumeGroupSnapshot
+}
+
+// MappedVolumeGroupError is returned by RestoreVolumeGroupFromSnapshot() when Hosts or Host Groups are mapped to the
+// Volume Group and RestoreOptions.Force is not set. Nothing has been changed on the Silk server.
+type MappedVolumeGroupError struct {
+	VolumeGroup string
+	Hosts       []string
+	HostGroups  []string
+}
+
+// Error implements the error interface.
+func (e *MappedVolumeGroupError) Error() string {
+	mapped := append(append([]string{}, e.Hosts...), e.HostGroups...)
+	return fmt.Sprintf("The Volume Group '%s' is mapped to '%s'. Unmap it or set Force to restore it", e.VolumeGroup, strings.Join(mapped, "', '"))
+}
+
+// RestoreVolumeGroupFromSnapshot restores the Volumes of a Volume Group to the content of one of its snapshots.
+//
+// The Hosts and Host Groups mapped to the Volume Group, or to one of its Volumes, are always reported in the returned
+// RestoreResult. Unless opts.Force is set, a mapped Volume Group is not restored: the RestoreResult is returned with a
+// MappedVolumeGroupError so the caller can quiesce the Hosts and retry. Once the restore has been requested, RestoreVolumeGroupFromSnapshot waits with WaitFor() until the
+// LastRestoredFrom field of the Volume Group points at the snapshot.
+func (c *Credentials) RestoreVolumeGroupFromSnapshot(volumeGroupName, snapshotName string, opts *RestoreOptions, timeout ...int) (*RestoreResult, error) {
+	return c.RestoreVolumeGroupFromSnapshotCtx(context.Background(), volumeGroupName, snapshotName, opts, timeout...)
+}
+
+// RestoreVolumeGroupFromSnapshotCtx is the context-aware form of RestoreVolumeGroupFromSnapshot.
+func (c *Credentials) RestoreVolumeGroupFromSnapshotCtx(ctx context.Context, volumeGroupName, snapshotName string, opts *RestoreOptions, timeout ...int) (*RestoreResult, error) {
+
+	httpTimeout := httpTimeout(timeout)
+
+	if opts == nil {
+		opts = &RestoreOptions{}
+	}
+
+	var volumeGroup VolumeGroup
+	if err := c.lookupObject(ctx, KindVolumeGroup, volumeGroupName, &volumeGroup, httpTimeout); err != nil {
+		return nil, err
+	}
+
+	var snapshot VolumeGroupSnapshot
+	if err := c.lookupObject(ctx, KindVolumeGroupSnapshot, snapshotName, &snapshot, httpTimeout); err != nil {
+		return nil, err
+	}
+
+	if snapshot.VolumeGroup != VolumeGroupRef(volumeGroup.ID) {
+		return nil, fmt.Errorf("The Volume Group Snapshot '%s' does not belong to the Volume Group '%s'", snapshotName, volumeGroupName)
+	}
+
+	result := &RestoreResult{}
+
+	hosts, hostGroups, err := c.volumeGroupMappedHosts(ctx, volumeGroup.ID, httpTimeout)
+	if err != nil {
+		return nil, err
+	}
+	result.MappedHosts = hosts
+	result.MappedHostGroups = hostGroups
+
+	if opts.Force == false && len(hosts)+len(hostGroups) != 0 {
+		return result, &MappedVolumeGroupError{VolumeGroup: volumeGroupName, Hosts: hosts, HostGroups: hostGroups}
+	}
+
+	if opts.SafetySnapshot != "" {
+		retentionPolicy := opts.SafetyRetentionPolicy
+		if retentionPolicy == "" {
+			// The safety snapshot must not be taken without a Retention Policy, so a failed lookup stops the restore
+			if snapshot.RetentionPolicy.IsZero() {
+				return nil, fmt.Errorf("The Volume Group Snapshot '%s' has no Retention Policy. Set SafetyRetentionPolicy to take the safety snapshot", snapshotName)
+			}
+			names, err := c.resolveNames(ctx, KindRetentionPolicy, []int{snapshot.RetentionPolicy.ID()}, httpTimeout)
+			if err != nil {
+				return nil, fmt.Errorf("Unable to find the Retention Policy of the Volume Group Snapshot '%s' for the safety snapshot: %w", snapshotName, err)
+			}
+			retentionPolicy = names[snapshot.RetentionPolicy.ID()]
+		}
+
+		safetySnapshot, err := c.createSnapshot(ctx, volumeGroupName, SnapshotOptions{Name: opts.SafetySnapshot, RetentionPolicy: retentionPolicy}, httpTimeout)
+		if err != nil {
+			return nil, fmt.Errorf("Unable to take the safety snapshot of the Volume Group '%s': %w", volumeGroupName, err)
+		}
+		result.SafetySnapshot = safetySnapshot
+	}
+
+	config := map[string]interface{}{}
+	config["last_restored_from"] = SnapshotRef(snapshot.ID)
+
+	if _, err := c.PatchCtx(ctx, fmt.Sprintf("/volume_groups/%d", volumeGroup.ID), config, httpTimeout); err != nil {
+		return nil, err
+	}
+
+	// A previous restore from the same snapshot leaves LastRestoredFrom unchanged, so the restore time must move as well
+	previousRestore := volumeGroup.LastRestoredTime
+	err = c.WaitFor(ctx, func(ctx context.Context) (bool, error) {
+		object, err := c.ResolveCtx(ctx, VolumeGroupRef(volumeGroup.ID), httpTimeout)
+		if err != nil {
+			return false, err
+		}
+		result.VolumeGroup = object.(*VolumeGroup)
+
+		if result.VolumeGroup.LastRestoredFrom != SnapshotRef(snapshot.ID) {
+			return false, nil
+		}
+		if previousRestore != nil && volumeGroup.LastRestoredFrom == SnapshotRef(snapshot.ID) {
+			return result.VolumeGroup.LastRestoredTime != nil && result.VolumeGroup.LastRestoredTime.After(*previousRestore), nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("The restore of the Volume Group '%s' from '%s' was not confirmed by the Silk server: %w", volumeGroupName, snapshotName, err)
+	}
+
+	return result, nil
+}
+
+// volumeGroupMappedHosts returns the names of the Hosts and Host Groups mapped to the Volume Group or to one of its
+// Volumes.
+func (c *Credentials) volumeGroupMappedHosts(ctx context.Context, volumeGroupID int, httpTimeout int) ([]string, []string, error) {
+
+	// Only the Volumes of the Volume Group are requested from the Silk server
+	apiRequest, err := c.getAllCtx(ctx, "/volumes", Filter().Field("volume_group").Equals(VolumeGroupRef(volumeGroupID)), httpTimeout)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var volumes GetVolumesResponse
+	mapErr := decode(apiRequest, &volumes)
+	if mapErr != nil {
+		return nil, nil, mapErr
+	}
+
+	mappedObjects := []interface{}{VolumeGroupRef(volumeGroupID)}
+	for _, volume := range volumes.Hits {
+		mappedObjects = append(mappedObjects, VolumeRef(volume.ID))
+	}
+
+	// The mappings are requested through volume__in queries of up to 100 objects to keep the URL short
+	var hostIDs, hostGroupIDs []int
+	for start := 0; start < len(mappedObjects); start += maxIDsPerLookup {
+		end := start + maxIDsPerLookup
+		if end > len(mappedObjects) {
+			end = len(mappedObjects)
+		}
+
+		apiRequest, err := c.getAllCtx(ctx, "/mappings", Filter().Field("volume").In(mappedObjects[start:end]...), httpTimeout)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var mappings GetHostMappingsResponse
+		mapErr := decode(apiRequest, &mappings)
+		if mapErr != nil {
+			return nil, nil, mapErr
+		}
+
+		for _, mapping := range mappings.Hits {
+			switch mapping.Host.Kind() {
+			case KindHost:
+				hostIDs = append(hostIDs, mapping.Host.ID())
+			case KindHostGroup:
+				hostGroupIDs = append(hostGroupIDs, mapping.Host.ID())
+			}
+		}
+	}
+
+	hosts, err := c.sortedNames(ctx, KindHost, hostIDs, httpTimeout)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hostGroups, err := c.sortedNames(ctx, KindHostGroup, hostGroupIDs, httpTimeout)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return hosts, hostGroups, nil
+}
+
+// sortedNames returns the sorted, deduplicated names of the objects of the provided kind and IDs.
+func (c *Credentials) sortedNames(ctx context.Context, kind ObjectKind, ids []int, httpTimeout int) ([]string, error) {
+
+	names, err := c.resolveNames(ctx, kind, ids, httpTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	sorted := []string{}
+	for _, name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+
+	return sorted, nil
+}
@@ -0,0 +1,79 @@
+package silksdp
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+)
+
+func Test_RestoreVolumeGroupFromSnapshot(t *testing.T) {
+	var mutex sync.Mutex
+	var lastRestoredFrom interface{}
+	var snapshotCreated bool
+	silk := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		hits := []interface{}{}
+		switch {
+		case r.Method == "PATCH" && r.URL.Path == "/api/v2/volume_groups/3":
+			var body map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&body)
+			lastRestoredFrom = body["last_restored_from"]
+			json.NewEncoder(w).Encode(map[string]interface{}{"id": 3, "name": "vg01"})
+			return
+		case r.Method == "POST" && r.URL.Path == "/api/v2/snapshots":
+			snapshotCreated = true
+			json.NewEncoder(w).Encode(map[string]interface{}{"id": 9, "name": "vg01:before-restore"})
+			return
+		case r.URL.Path == "/api/v2/volume_groups":
+			hits = append(hits, map[string]interface{}{"id": 3, "name": "vg01", "last_restored_from": lastRestoredFrom})
+		case r.URL.Path == "/api/v2/snapshots":
+			hits = append(hits, map[string]interface{}{"id": 8, "name": "vg01:daily", "volume_group": map[string]interface{}{"ref": "/volume_groups/3"}})
+		case r.URL.Path == "/api/v2/volumes":
+			// Only the Volumes of the Volume Group may be requested
+			if r.URL.Query().Get("volume_group") != "/volume_groups/3" {
+				t.Errorf("Expected the Volumes to be filtered by Volume Group, got %s", r.URL)
+			}
+			hits = append(hits, map[string]interface{}{"id": 2, "name": "vol01", "volume_group": map[string]interface{}{"ref": "/volume_groups/3"}})
+		case r.URL.Path == "/api/v2/mappings":
+			if r.URL.Query().Get("volume__in") != "/volume_groups/3,/volumes/2" {
+				t.Errorf("Expected the mappings to be filtered by Volume, got %s", r.URL)
+			}
+			hits = append(hits, map[string]interface{}{"id": 40, "host": map[string]interface{}{"ref": "/hosts/5"}, "volume": map[string]interface{}{"ref": "/volumes/2"}})
+		case r.URL.Path == "/api/v2/hosts":
+			hits = append(hits, map[string]interface{}{"id": 5, "name": "db01"})
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL)
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"hits": hits, "total": len(hits)})
+	})
+
+	result, err := silk.RestoreVolumeGroupFromSnapshot("vg01", "vg01:daily", nil)
+	var mappedErr *MappedVolumeGroupError
+	if errors.As(err, &mappedErr) == false || len(mappedErr.Hosts) != 1 || mappedErr.Hosts[0] != "db01" {
+		t.Errorf("Expected the mapped Host to refuse the restore, got %v", err)
+	}
+	if result == nil || len(result.MappedHosts) != 1 || result.MappedHosts[0] != "db01" {
+		t.Errorf("Expected the mapped Host to be reported, got %+v", result)
+	}
+	if lastRestoredFrom != nil {
+		t.Errorf("Expected the refused restore not to send a PATCH request")
+	}
+
+	// The snapshot has no Retention Policy, so the safety snapshot can not be taken
+	_, err = silk.RestoreVolumeGroupFromSnapshot("vg01", "vg01:daily", &RestoreOptions{Force: true, SafetySnapshot: "before-restore"})
+	if err == nil || snapshotCreated || lastRestoredFrom != nil {
+		t.Errorf("Expected the restore to stop before the safety snapshot, got %v", err)
+	}
+
+	result, err = silk.RestoreVolumeGroupFromSnapshot("vg01", "vg01:daily", &RestoreOptions{Force: true})
+	if err != nil {
+		t.Fatalf("Failed to restore the volume group: %v", err)
+	}
+	if len(result.MappedHosts) != 1 || result.MappedHosts[0] != "db01" || result.VolumeGroup.LastRestoredFrom != SnapshotRef(8) {
+		t.Errorf("Unexpected result: %+v", result)
+	}
+}
@@ -1,22 +1,50 @@
 package silksdp
 
 import (
+	"context"
 	"fmt"
-
-	"github.com/mitchellh/mapstructure"
 )
 
 // GetRetentionPolicy returns information on all Retention Policies found on the Silk server.
 func (c *Credentials) GetRetentionPolicy(timeout ...int) (*GetRetentionPolicyResponse, error) {
+	return c.GetRetentionPolicyCtx(context.Background(), timeout...)
+}
+
+// GetRetentionPolicyCtx is the context-aware form of GetRetentionPolicy.
+func (c *Credentials) GetRetentionPolicyCtx(ctx context.Context, timeout ...int) (*GetRetentionPolicyResponse, error) {
 	httpTimeout := httpTimeout(timeout)
 
-	apiRequest, err := c.Get("/retention_policies", httpTimeout)
+	apiRequest, err := c.getAllCtx(ctx, "/retention_policies", nil, httpTimeout)
+	if err != nil {
+		return nil, err
+	}
+	// Convert the API Response (map[string]interface{}) to a struct
+	var apiResponse GetRetentionPolicyResponse
+	mapErr := decode(apiRequest, &apiResponse)
+	if mapErr != nil {
+		return nil, mapErr
+	}
+
+	return &apiResponse, nil
+}
+
+// FindRetentionPolicies returns the Retention Policies matching the provided filter, fetching every page of the
+// results (ex: silksdp.Filter().Name().Contains("daily")).
+func (c *Credentials) FindRetentionPolicies(filter ListFilter, timeout ...int) (*GetRetentionPolicyResponse, error) {
+	return c.FindRetentionPoliciesCtx(context.Background(), filter, timeout...)
+}
+
+// FindRetentionPoliciesCtx is the context-aware form of FindRetentionPolicies.
+func (c *Credentials) FindRetentionPoliciesCtx(ctx context.Context, filter ListFilter, timeout ...int) (*GetRetentionPolicyResponse, error) {
+
+	apiRequest, err := c.getAllCtx(ctx, "/retention_policies", filter, httpTimeout(timeout))
 	if err != nil {
 		return nil, err
 	}
+
 	// Convert the API Response (map[string]interface{}) to a struct
 	var apiResponse GetRetentionPolicyResponse
-	mapErr := mapstructure.Decode(apiRequest, &apiResponse)
+	mapErr := decode(apiRequest, &apiResponse)
 	if mapErr != nil {
 		return nil, mapErr
 	}
@@ -26,22 +54,27 @@ func (c *Credentials) GetRetentionPolicy(timeout ...int) (*GetRetentionPolicyRes
 
 // DeleteRetentionPolicy deletes a Retention Policy from the Silk server.
 func (c *Credentials) DeleteRetentionPolicy(name string, timeout ...int) (*DeleteResponse, error) {
+	return c.DeleteRetentionPolicyCtx(context.Background(), name, timeout...)
+}
+
+// DeleteRetentionPolicyCtx is the context-aware form of DeleteRetentionPolicy.
+func (c *Credentials) DeleteRetentionPolicyCtx(ctx context.Context, name string, timeout ...int) (*DeleteResponse, error) {
 
 	httpTimeout := httpTimeout(timeout)
 
-	RetentionPolicyID, err := c.GetRetentionPolicyID(name, httpTimeout)
+	RetentionPolicyID, err := c.GetRetentionPolicyIDCtx(ctx, name, httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 
-	apiRequest, err := c.Delete(fmt.Sprintf("/retention_policies/%d", RetentionPolicyID), httpTimeout)
+	apiRequest, err := c.DeleteCtx(ctx, fmt.Sprintf("/retention_policies/%d", RetentionPolicyID), httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 
 	// Convert the API Response (map[string]interface{}) to a struct
 	var apiResponse DeleteResponse
-	mapErr := mapstructure.Decode(apiRequest, &apiResponse)
+	mapErr := decode(apiRequest, &apiResponse)
 	if mapErr != nil {
 		return nil, mapErr
 	}
@@ -52,34 +85,24 @@ func (c *Credentials) DeleteRetentionPolicy(name string, timeout ...int) (*Delet
 
 // GetRetentionPolicyID is a quick function for grabbing a retention policy object ID
 func (c *Credentials) GetRetentionPolicyID(name string, timeout ...int) (int, error) {
+	return c.GetRetentionPolicyIDCtx(context.Background(), name, timeout...)
+}
 
-	httpTimeout := httpTimeout(timeout)
-
-	objectsOnServer, err := c.GetRetentionPolicy(httpTimeout)
-	if err != nil {
-		return 0, err
-	}
-
-	// Set objectID to a value (-1) that can not be returned by the server
-	objectID := -1
-	for _, object := range objectsOnServer.Hits {
-		if object.Name == name {
-			objectID = object.ID
-		}
-
-	}
-
-	// If the objectID has not been updated (i.e not found on the server) return an error message
-	if objectID == -1 {
-		return 0, fmt.Errorf("The server does not contain a Retention Policy named '%s'", name)
-	}
+// GetRetentionPolicyIDCtx is the context-aware form of GetRetentionPolicyID.
+func (c *Credentials) GetRetentionPolicyIDCtx(ctx context.Context, name string, timeout ...int) (int, error) {
 
-	return objectID, nil
+	httpTimeout := httpTimeout(timeout)
 
+	return c.lookupID(ctx, KindRetentionPolicy, name, httpTimeout)
 }
 
 // CreateRetentionPolicy creates a new Retention Policy on the Silk server.
 func (c *Credentials) CreateRetentionPolicy(name string, numsnapshots string, weeks string, days string, hours string, timeout ...int) (*CreateOrUpdateRetentionPolicyResponse, error) {
+	return c.CreateRetentionPolicyCtx(context.Background(), name, numsnapshots, weeks, days, hours, timeout...)
+}
+
+// CreateRetentionPolicyCtx is the context-aware form of CreateRetentionPolicy.
+func (c *Credentials) CreateRetentionPolicyCtx(ctx context.Context, name string, numsnapshots string, weeks string, days string, hours string, timeout ...int) (*CreateOrUpdateRetentionPolicyResponse, error) {
 
 	httpTimeout := httpTimeout(timeout)
 
@@ -90,13 +113,13 @@ func (c *Credentials) CreateRetentionPolicy(name string, numsnapshots string, we
 	config["days"] = days
 	config["hours"] = hours
 
-	apiRequest, err := c.Post("/retention_policies", config, httpTimeout)
+	apiRequest, err := c.PostCtx(ctx, "/retention_policies", config, httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 
 	var apiResponse CreateOrUpdateRetentionPolicyResponse
-	mapErr := mapstructure.Decode(apiRequest, &apiResponse)
+	mapErr := decode(apiRequest, &apiResponse, numericStringHook)
 	if mapErr != nil {
 		return nil, mapErr
 	}
@@ -104,17 +127,40 @@ func (c *Credentials) CreateRetentionPolicy(name string, numsnapshots string, we
 	return &apiResponse, nil
 }
 
+// RetentionPolicyUpdate holds the changes applied to a Retention Policy by ApplyRetentionPolicyUpdate(). Only the
+// fields that are set are sent to the Silk server.
+type RetentionPolicyUpdate struct {
+	// Name renames the Retention Policy.
+	Name *string
+	// NumSnapshots sets the number of snapshots to keep.
+	NumSnapshots *int
+	// Weeks sets the number of weeks snapshots are kept for.
+	Weeks *int
+	// Days sets the number of days snapshots are kept for.
+	Days *int
+	// Hours sets the number of hours snapshots are kept for.
+	Hours *int
+}
+
 // UpdateRetentionPolicy updates the Retention Policy with the provided config options.
 //
 // Valid config keys are: name, num_snapshots, weeks, days, and hours.
+//
+// Deprecated: Use ApplyRetentionPolicyUpdate() and a RetentionPolicyUpdate, which are validated at compile time.
 func (c *Credentials) UpdateRetentionPolicy(name string, config map[string]interface{}, timeout ...int) (*CreateOrUpdateRetentionPolicyResponse, error) {
+	return c.UpdateRetentionPolicyCtx(context.Background(), name, config, timeout...)
+}
+
+// UpdateRetentionPolicyCtx is the context-aware form of UpdateRetentionPolicy.
+//
+// Deprecated: Use ApplyRetentionPolicyUpdateCtx() and a RetentionPolicyUpdate, which are validated at compile time.
+func (c *Credentials) UpdateRetentionPolicyCtx(ctx context.Context, name string, config map[string]interface{}, timeout ...int) (*CreateOrUpdateRetentionPolicyResponse, error) {
 	httpTimeout := httpTimeout(timeout)
 
 	// Validate that the user provided keys are valid for this API
 	validUpdateKeys := []string{"name", "num_snapshots", "weeks", "days", "hours"}
 	var invalidUserProvidedKeys []string
 	for key := range config {
-
 		if c.stringInSlice(validUpdateKeys, key) == false {
 			invalidUserProvidedKeys = append(invalidUserProvidedKeys, key)
 		}
@@ -125,19 +171,59 @@ func (c *Credentials) UpdateRetentionPolicy(name string, config map[string]inter
 		return nil, fmt.Errorf("The provided 'config' parameter contains invalid keys. 'name', 'num_snapshots', 'weeks', 'days' and 'hours' are the only valid choices")
 	}
 
-	RetentionPolicyID, err := c.GetRetentionPolicyID(name, httpTimeout)
+	return c.updateRetentionPolicy(ctx, name, config, httpTimeout)
+}
+
+// ApplyRetentionPolicyUpdate applies the provided changes to a Retention Policy. Only the fields of the update that are
+// set are sent to the Silk server, and an error is returned if none are.
+func (c *Credentials) ApplyRetentionPolicyUpdate(name string, update RetentionPolicyUpdate, timeout ...int) (*CreateOrUpdateRetentionPolicyResponse, error) {
+	return c.ApplyRetentionPolicyUpdateCtx(context.Background(), name, update, timeout...)
+}
+
+// ApplyRetentionPolicyUpdateCtx is the context-aware form of ApplyRetentionPolicyUpdate.
+func (c *Credentials) ApplyRetentionPolicyUpdateCtx(ctx context.Context, name string, update RetentionPolicyUpdate, timeout ...int) (*CreateOrUpdateRetentionPolicyResponse, error) {
+
+	config := map[string]interface{}{}
+	if update.Name != nil {
+		config["name"] = *update.Name
+	}
+	if update.NumSnapshots != nil {
+		config["num_snapshots"] = *update.NumSnapshots
+	}
+	if update.Weeks != nil {
+		config["weeks"] = *update.Weeks
+	}
+	if update.Days != nil {
+		config["days"] = *update.Days
+	}
+	if update.Hours != nil {
+		config["hours"] = *update.Hours
+	}
+
+	if len(config) == 0 {
+		return nil, errNoChanges
+	}
+
+	return c.updateRetentionPolicy(ctx, name, config, httpTimeout(timeout))
+}
+
+// updateRetentionPolicy sends the PATCH request shared by UpdateRetentionPolicyCtx() and
+// ApplyRetentionPolicyUpdateCtx().
+func (c *Credentials) updateRetentionPolicy(ctx context.Context, name string, config map[string]interface{}, httpTimeout int) (*CreateOrUpdateRetentionPolicyResponse, error) {
+
+	RetentionPolicyID, err := c.GetRetentionPolicyIDCtx(ctx, name, httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 
-	apiRequest, err := c.Patch(fmt.Sprintf("/retention_policies/%d", RetentionPolicyID), config, httpTimeout)
+	apiRequest, err := c.PatchCtx(ctx, fmt.Sprintf("/retention_policies/%d", RetentionPolicyID), config, httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 
 	// Convert the API Response (map[string]interface{}) to a struct
 	var apiResponse CreateOrUpdateRetentionPolicyResponse
-	mapErr := mapstructure.Decode(apiRequest, &apiResponse)
+	mapErr := decode(apiRequest, &apiResponse, numericStringHook)
 	if mapErr != nil {
 		return nil, mapErr
 	}
@@ -145,22 +231,26 @@ func (c *Credentials) UpdateRetentionPolicy(name string, config map[string]inter
 	return &apiResponse, nil
 
 }
+
 // GetRetentionPolicyByName returns information on all Retention Policies found on the Silk server.
 func (c *Credentials) GetRetentionPolicyByName(retentionpolicyname string, timeout ...int) (*GetRetentionPolicyResponse, error) {
-	httpTimeout := httpTimeout(timeout)
+	return c.GetRetentionPolicyByNameCtx(context.Background(), retentionpolicyname, timeout...)
+}
 
-	enduri := ("/retention_policies?name__contains=" + retentionpolicyname)
+// GetRetentionPolicyByNameCtx is the context-aware form of GetRetentionPolicyByName.
+func (c *Credentials) GetRetentionPolicyByNameCtx(ctx context.Context, retentionpolicyname string, timeout ...int) (*GetRetentionPolicyResponse, error) {
+	httpTimeout := httpTimeout(timeout)
 
-	apiRequest, err := c.Get(enduri, httpTimeout)
+	apiRequest, err := c.getAllCtx(ctx, "/retention_policies", Filter().Name().Contains(retentionpolicyname), httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 	// Convert the API Response (map[string]interface{}) to a struct
 	var apiResponse GetRetentionPolicyResponse
-	mapErr := mapstructure.Decode(apiRequest, &apiResponse)
+	mapErr := decode(apiRequest, &apiResponse)
 	if mapErr != nil {
 		return nil, mapErr
 	}
 
 	return &apiResponse, nil
-}
\ No newline at end of file
+}
@@ -0,0 +1,192 @@
+package silksdp
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RetryPolicy controls how requests that fail with a transient error are sent again. A request is retried when the
+// connection to the Silk server fails or the server answers with one of the RetryableStatusCodes, as long as its HTTP method
+// is listed in Methods. A 429 Too Many Requests answer means the server did not process the request, so it is retried
+// whatever the method.
+//
+// The pause between two attempts grows exponentially from InitialBackoff up to MaxBackoff. A Retry-After header sent by
+// the server takes precedence over the computed pause.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one. A value of 1 disables retries.
+	MaxAttempts int
+	// InitialBackoff is the pause before the second attempt.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the pause between two attempts.
+	MaxBackoff time.Duration
+	// Multiplier is applied to the pause after every attempt.
+	Multiplier float64
+	// Jitter randomizes each pause by up to the given fraction (ex: 0.2 for +/- 20%) so concurrent clients do not retry
+	// in lockstep.
+	Jitter float64
+	// RetryableStatusCodes lists the HTTP status codes considered transient.
+	RetryableStatusCodes []int
+	// Methods lists the HTTP methods that are safe to retry. Only GET is retried by default; add POST, PATCH, or DELETE
+	// when sending the same request twice can not cause harm.
+	Methods []string
+}
+
+// DefaultRetryPolicy returns the policy used when WithRetryPolicy() has not been provided: up to 3 attempts of GET
+// requests failing with a connection error, 429, 502, 503, or 504.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:          3,
+		InitialBackoff:       500 * time.Millisecond,
+		MaxBackoff:           10 * time.Second,
+		Multiplier:           2,
+		Jitter:               0.2,
+		RetryableStatusCodes: []int{http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout},
+		Methods:              []string{"GET"},
+	}
+}
+
+// NoRetryPolicy returns a policy that sends every request exactly once.
+func NoRetryPolicy() RetryPolicy {
+	return RetryPolicy{MaxAttempts: 1}
+}
+
+type retryPolicyKey struct{}
+
+// ContextWithRetryPolicy returns a copy of ctx that overrides the client retry policy for the calls made with it. This
+// is how a single call opts into retrying a POST or DELETE request that is known to be safe to repeat.
+func ContextWithRetryPolicy(ctx context.Context, policy RetryPolicy) context.Context {
+	return context.WithValue(ctx, retryPolicyKey{}, policy)
+}
+
+// retryPolicy returns the policy that applies to a request made with ctx.
+func (c *Credentials) retryPolicy(ctx context.Context) RetryPolicy {
+	if policy, ok := ctx.Value(retryPolicyKey{}).(RetryPolicy); ok {
+		return policy
+	}
+	if c.retry != nil {
+		return *c.retry
+	}
+	return DefaultRetryPolicy()
+}
+
+// shouldRetry reports whether the outcome of an attempt warrants sending the request again and how long to wait first.
+func (p RetryPolicy) shouldRetry(ctx context.Context, callType string, attempt int, apiRequest *httpResult, err error) (time.Duration, bool) {
+
+	if attempt >= p.MaxAttempts || ctx.Err() != nil {
+		return 0, false
+	}
+
+	methodAllowed := false
+	for _, method := range p.Methods {
+		if strings.EqualFold(method, callType) {
+			methodAllowed = true
+		}
+	}
+
+	if err != nil {
+		if methodAllowed && isTransientError(err) {
+			return p.backoff(attempt), true
+		}
+		return 0, false
+	}
+
+	retryableStatus := false
+	for _, statusCode := range p.RetryableStatusCodes {
+		if apiRequest.statusCode == statusCode {
+			retryableStatus = true
+		}
+	}
+	if retryableStatus == false {
+		return 0, false
+	}
+	if methodAllowed == false && apiRequest.statusCode != http.StatusTooManyRequests {
+		return 0, false
+	}
+
+	if wait, ok := retryAfter(apiRequest); ok {
+		return wait, true
+	}
+	return p.backoff(attempt), true
+}
+
+// backoff returns the pause that follows the provided attempt.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+
+	wait := float64(p.InitialBackoff) * math.Pow(multiplier, float64(attempt-1))
+	if p.MaxBackoff > 0 && wait > float64(p.MaxBackoff) {
+		wait = float64(p.MaxBackoff)
+	}
+	if p.Jitter > 0 {
+		wait = wait * (1 - p.Jitter + 2*p.Jitter*rand.Float64())
+	}
+
+	return time.Duration(wait)
+}
+
+// isTransientError reports whether a transport error is worth retrying. Certificate errors will not go away by sending
+// the request again.
+func isTransientError(err error) bool {
+	var unknownAuthorityError x509.UnknownAuthorityError
+	var certificateInvalidError x509.CertificateInvalidError
+	var hostnameError x509.HostnameError
+	if errors.As(err, &unknownAuthorityError) || errors.As(err, &certificateInvalidError) || errors.As(err, &hostnameError) {
+		return false
+	}
+	return true
+}
+
+// maxRetryAfterWait is the longest Retry-After hint that is honored. Longer hints fall back to the computed backoff.
+const maxRetryAfterWait = 30 * time.Second
+
+// retryAfter reports how long the server asked to wait before sending the request again through a Retry-After header,
+// expressed either in seconds or as an HTTP date.
+func retryAfter(apiRequest *httpResult) (time.Duration, bool) {
+
+	hint := apiRequest.header.Get("Retry-After")
+	if hint == "" {
+		return 0, false
+	}
+
+	var wait time.Duration
+	if seconds, err := strconv.Atoi(hint); err == nil {
+		wait = time.Duration(seconds) * time.Second
+	} else if date, err := http.ParseTime(hint); err == nil {
+		wait = time.Until(date)
+	} else {
+		return 0, false
+	}
+
+	if wait < 0 {
+		wait = 0
+	}
+	if wait > maxRetryAfterWait {
+		return 0, false
+	}
+
+	return wait, true
+}
+
+// sleepCtx pauses for the provided duration or until ctx is done, whichever happens first.
+func sleepCtx(ctx context.Context, duration time.Duration) error {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,67 @@
+package silksdp
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// fastRetryPolicy keeps the unit tests quick while exercising the retry loop.
+func fastRetryPolicy() RetryPolicy {
+	policy := DefaultRetryPolicy()
+	policy.InitialBackoff = time.Millisecond
+	policy.MaxBackoff = 5 * time.Millisecond
+	return policy
+}
+
+func Test_RetryPolicy(t *testing.T) {
+	var calls int
+	silk := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Write([]byte(`{"hits": []}`))
+	}, WithRetryPolicy(fastRetryPolicy()))
+
+	// GET is idempotent and retried until it succeeds
+	if _, err := silk.Get("/hosts"); err != nil {
+		t.Errorf("Failed to fetch hosts after transient failures: %v", err)
+	}
+	if calls != 3 {
+		t.Errorf("Expected 3 requests, got %d", calls)
+	}
+
+	// POST is not retried unless the caller opts in
+	calls = 0
+	if _, err := silk.Post("/hosts", map[string]interface{}{"name": "host"}); hasStatusCode(err, http.StatusBadGateway) == false {
+		t.Errorf("Expected a 502 APIError, got: %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("Expected 1 request, got %d", calls)
+	}
+
+	calls = 0
+	policy := fastRetryPolicy()
+	policy.Methods = append(policy.Methods, "POST")
+	ctx := ContextWithRetryPolicy(context.Background(), policy)
+	if _, err := silk.PostCtx(ctx, "/hosts", map[string]interface{}{"name": "host"}); err != nil {
+		t.Errorf("Failed to create host after transient failures: %v", err)
+	}
+	if calls != 3 {
+		t.Errorf("Expected 3 requests, got %d", calls)
+	}
+}
+
+func Test_RetryPolicyBackoff(t *testing.T) {
+	policy := RetryPolicy{InitialBackoff: time.Second, MaxBackoff: 5 * time.Second, Multiplier: 2}
+
+	expected := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second}
+	for i, wait := range expected {
+		if got := policy.backoff(i + 1); got != wait {
+			t.Errorf("Expected a backoff of %v after attempt %d, got %v", wait, i+1, got)
+		}
+	}
+}
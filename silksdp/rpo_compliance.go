@@ -0,0 +1,144 @@
+package silksdp
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// RPOCompliance holds the RPO compliance of a single replicated Volume Group.
+type RPOCompliance struct {
+	// VolumeGroup is the name of the replicated Volume Group.
+	VolumeGroup string
+	// Session is the name of the Replication Session of the Volume Group.
+	Session string
+	// Target is the target RPO of the Replication Session.
+	Target time.Duration
+	// Samples is the number of RPO history samples taken into account.
+	Samples int
+	// WorstLag is the largest replication lag found in the samples.
+	WorstLag time.Duration
+	// WithinTarget is the percentage of the samples whose lag does not exceed the target RPO. It is 100 when there are
+	// no samples.
+	WithinTarget float64
+	// Breaching is set when the Replication Session currently does not meet its target RPO.
+	Breaching bool
+}
+
+// RPOComplianceReport holds the RPO compliance of every replicated Volume Group, sorted by Volume Group name.
+type RPOComplianceReport struct {
+	Groups []RPOCompliance
+	// Breaching holds the names of the Volume Groups that currently do not meet their target RPO.
+	Breaching []string
+}
+
+// GetRPOCompliance walks every Volume Group with a Replication Session and reports how well its RPO history meets the
+// target RPO of the session. Only the samples taken at or after since are counted; the whole history is used when
+// since is the zero time.
+//
+// A Volume Group is currently breaching when its running session reports a current RPO above the target, or when its
+// most recent sample exceeds the target.
+func (c *Credentials) GetRPOCompliance(since time.Time, timeout ...int) (*RPOComplianceReport, error) {
+	return c.GetRPOComplianceCtx(context.Background(), since, timeout...)
+}
+
+// GetRPOComplianceCtx is the context-aware form of GetRPOCompliance.
+func (c *Credentials) GetRPOComplianceCtx(ctx context.Context, since time.Time, timeout ...int) (*RPOComplianceReport, error) {
+
+	httpTimeout := httpTimeout(timeout)
+
+	apiRequest, err := c.getAllCtx(ctx, "/volume_groups", nil, httpTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert the API Response (map[string]interface{}) to a struct. The history is decoded separately for each Volume
+	// Group so that a malformed sample can be reported along with the name of its Volume Group.
+	var volumeGroups struct {
+		Hits []struct {
+			Name               string      `mapstructure:"name"`
+			ReplicationSession Ref         `mapstructure:"replication_session"`
+			History            interface{} `mapstructure:"replication_rpo_history"`
+		} `mapstructure:"hits"`
+	}
+	mapErr := decode(apiRequest, &volumeGroups)
+	if mapErr != nil {
+		return nil, mapErr
+	}
+
+	sessions, err := c.GetReplicationSessionsCtx(ctx, httpTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionsByRef := map[Ref]ReplicationSession{}
+	for _, session := range sessions.Hits {
+		sessionsByRef[ReplicationSessionRef(session.ID)] = session
+	}
+
+	report := &RPOComplianceReport{Groups: []RPOCompliance{}, Breaching: []string{}}
+	for _, volumeGroup := range volumeGroups.Hits {
+		session, ok := sessionsByRef[volumeGroup.ReplicationSession]
+		if volumeGroup.ReplicationSession.IsZero() || ok == false {
+			continue
+		}
+
+		var history []RPOSample
+		if err := decode(volumeGroup.History, &history); err != nil {
+			return nil, fmt.Errorf("The RPO history of the Volume Group '%s' could not be decoded: %w", volumeGroup.Name, err)
+		}
+
+		compliance := rpoCompliance(session, history, since)
+		compliance.VolumeGroup = volumeGroup.Name
+		report.Groups = append(report.Groups, compliance)
+		if compliance.Breaching {
+			report.Breaching = append(report.Breaching, volumeGroup.Name)
+		}
+	}
+
+	sort.Slice(report.Groups, func(i, j int) bool {
+		return report.Groups[i].VolumeGroup < report.Groups[j].VolumeGroup
+	})
+	sort.Strings(report.Breaching)
+
+	return report, nil
+}
+
+// rpoCompliance measures the samples taken at or after since against the target RPO of the session.
+func rpoCompliance(session ReplicationSession, history []RPOSample, since time.Time) RPOCompliance {
+
+	compliance := RPOCompliance{
+		Session:   session.Name,
+		Target:    session.RPO,
+		Breaching: session.RPOStatus().Breached,
+	}
+
+	var latest RPOSample
+	var within int
+	for _, sample := range history {
+		if sample.Time.Before(since) {
+			continue
+		}
+		compliance.Samples++
+		if sample.Lag <= session.RPO {
+			within++
+		}
+		if sample.Lag > compliance.WorstLag {
+			compliance.WorstLag = sample.Lag
+		}
+		if sample.Time.After(latest.Time) || compliance.Samples == 1 {
+			latest = sample
+		}
+	}
+
+	compliance.WithinTarget = 100
+	if compliance.Samples > 0 {
+		compliance.WithinTarget = float64(within) * 100 / float64(compliance.Samples)
+		if latest.Lag > session.RPO {
+			compliance.Breaching = true
+		}
+	}
+
+	return compliance
+}
@@ -0,0 +1,93 @@
+package silksdp
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_GetRPOCompliance(t *testing.T) {
+	silk := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		hits := []interface{}{}
+		switch r.URL.Path {
+		case "/api/v2/volume_groups":
+			hits = append(hits,
+				map[string]interface{}{"id": 1, "name": "vg02", "replication_session": map[string]interface{}{"ref": "/replication/sessions/8"}, "replication_rpo_history": []interface{}{
+					map[string]interface{}{"timestamp": 1600000000, "rpo": 100},
+					map[string]interface{}{"timestamp": 1600000300, "rpo": 200},
+					map[string]interface{}{"timestamp": 1600000600, "rpo": 400},
+					map[string]interface{}{"timestamp": 1600000900, "rpo": 250},
+				}},
+				map[string]interface{}{"id": 2, "name": "vg01", "replication_session": map[string]interface{}{"ref": "/replication/sessions/9"}, "replication_rpo_history": []interface{}{
+					map[string]interface{}{"timestamp": 1600000000, "rpo": 900},
+					map[string]interface{}{"timestamp": 1600000300, "rpo": 100},
+					map[string]interface{}{"timestamp": 1600000600, "rpo": 700},
+				}},
+				map[string]interface{}{"id": 3, "name": "local"},
+			)
+		case "/api/v2/replication/sessions":
+			hits = append(hits,
+				map[string]interface{}{"id": 8, "name": "dr02", "state": "running", "rpo": 300, "current_rpo": 250},
+				map[string]interface{}{"id": 9, "name": "dr01", "state": "running", "rpo": 600, "current_rpo": 100},
+			)
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL)
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"hits": hits, "total": len(hits)})
+	})
+
+	volumeGroups, err := silk.GetVolumeGroups()
+	if err != nil {
+		t.Fatalf("Failed to get the Volume Groups: %v", err)
+	}
+	if history := volumeGroups.Hits[0].ReplicationRpoHistory; len(history) != 4 || history[2] != (RPOSample{Lag: 400 * time.Second, Time: time.Unix(1600000600, 0)}) {
+		t.Errorf("Unexpected RPO history: %v", history)
+	}
+
+	report, err := silk.GetRPOCompliance(time.Unix(1600000300, 0))
+	if err != nil {
+		t.Fatalf("Failed to build the compliance report: %v", err)
+	}
+
+	expected := []RPOCompliance{
+		{VolumeGroup: "vg01", Session: "dr01", Target: 10 * time.Minute, Samples: 2, WorstLag: 700 * time.Second, WithinTarget: 50, Breaching: true},
+		{VolumeGroup: "vg02", Session: "dr02", Target: 5 * time.Minute, Samples: 3, WorstLag: 400 * time.Second, WithinTarget: 200.0 / 3, Breaching: false},
+	}
+	if reflect.DeepEqual(report.Groups, expected) == false {
+		t.Errorf("Unexpected compliance:\n%+v\nexpected:\n%+v", report.Groups, expected)
+	}
+	if reflect.DeepEqual(report.Breaching, []string{"vg01"}) == false {
+		t.Errorf("Unexpected breaching Volume Groups: %v", report.Breaching)
+	}
+}
+
+func Test_GetRPOComplianceRejectsBadSamples(t *testing.T) {
+	silk := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		hits := []interface{}{}
+		switch r.URL.Path {
+		case "/api/v2/volume_groups":
+			hits = append(hits,
+				map[string]interface{}{"id": 1, "name": "vg01", "replication_session": map[string]interface{}{"ref": "/replication/sessions/9"}, "replication_rpo_history": []interface{}{
+					map[string]interface{}{"timestamp": 1600000000, "rpo": "late"},
+				}},
+			)
+		case "/api/v2/replication/sessions":
+			hits = append(hits, map[string]interface{}{"id": 9, "name": "dr01", "state": "running", "rpo": 600, "current_rpo": 100})
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL)
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"hits": hits, "total": len(hits)})
+	})
+
+	if _, err := silk.GetVolumeGroups(); err == nil {
+		t.Errorf("Expected the malformed RPO history to fail the decoding of the Volume Groups")
+	}
+
+	_, err := silk.GetRPOCompliance(time.Time{})
+	if err == nil || strings.Contains(err.Error(), "vg01") == false {
+		t.Errorf("Expected the malformed RPO history of vg01 to be reported, got %v", err)
+	}
+}
@@ -0,0 +1,314 @@
+package silksdp
+
+import (
+	"context"
+	"fmt"
+)
+
+// SnapshotViewOptions holds the optional settings of CreateSnapshotView().
+type SnapshotViewOptions struct {
+	// RetentionPolicy is the name of the Retention Policy of the view. The Retention Policy of the source snapshot is
+	// used when empty.
+	RetentionPolicy string
+	// Deletable allows the view to be deleted automatically by its Retention Policy.
+	Deletable bool
+}
+
+// CreateSnapshotView creates a view of an exposable Volume Group Snapshot. A view can be mapped to Hosts and Host
+// Groups with CreateHostSnapshotViewMapping() and CreateHostGroupSnapshotViewMapping(), which makes the Volumes of the
+// snapshot available to them (ex: to mount a snapshot on a backup proxy).
+func (c *Credentials) CreateSnapshotView(snapshotName, viewName string, opts *SnapshotViewOptions, timeout ...int) (*VolumeGroupSnapshot, error) {
+	return c.CreateSnapshotViewCtx(context.Background(), snapshotName, viewName, opts, timeout...)
+}
+
+// CreateSnapshotViewCtx is the context-aware form of CreateSnapshotView.
+func (c *Credentials) CreateSnapshotViewCtx(ctx context.Context, snapshotName, viewName string, opts *SnapshotViewOptions, timeout ...int) (*VolumeGroupSnapshot, error) {
+
+	httpTimeout := httpTimeout(timeout)
+
+	if opts == nil {
+		opts = &SnapshotViewOptions{}
+	}
+
+	var snapshot VolumeGroupSnapshot
+	if err := c.lookupObject(ctx, KindVolumeGroupSnapshot, snapshotName, &snapshot, httpTimeout); err != nil {
+		return nil, err
+	}
+
+	if snapshot.IsExposable == false {
+		return nil, fmt.Errorf("The Volume Group Snapshot '%s' is not exposable and can not be used to create a view", snapshotName)
+	}
+
+	retentionPolicy := snapshot.RetentionPolicy
+	if opts.RetentionPolicy != "" {
+		retentionPolicyID, err := c.GetRetentionPolicyIDCtx(ctx, opts.RetentionPolicy, httpTimeout)
+		if err != nil {
+			return nil, err
+		}
+		retentionPolicy = RetentionPolicyRef(retentionPolicyID)
+	}
+
+	config := map[string]interface{}{}
+	config["source"] = SnapshotRef(snapshot.ID)
+	config["short_name"] = viewName
+	config["retention_policy"] = retentionPolicy
+	config["is_exposable"] = true
+	config["is_auto_deleteable"] = opts.Deletable
+
+	apiRequest, err := c.PostCtx(ctx, "/snapshots", config, httpTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert the API Response (map[string]interface{}) to a struct
+	var apiResponse VolumeGroupSnapshot
+	mapErr := decode(apiRequest, &apiResponse)
+	if mapErr != nil {
+		return nil, mapErr
+	}
+
+	return &apiResponse, nil
+}
+
+// GetSnapshotViews returns the views created from the provided Volume Group Snapshot, or every view on the Silk server
+// when snapshotName is empty.
+func (c *Credentials) GetSnapshotViews(snapshotName string, timeout ...int) ([]VolumeGroupSnapshot, error) {
+	return c.GetSnapshotViewsCtx(context.Background(), snapshotName, timeout...)
+}
+
+// GetSnapshotViewsCtx is the context-aware form of GetSnapshotViews.
+func (c *Credentials) GetSnapshotViewsCtx(ctx context.Context, snapshotName string, timeout ...int) ([]VolumeGroupSnapshot, error) {
+
+	httpTimeout := httpTimeout(timeout)
+
+	// Only the views of the snapshot are requested from the Silk server when a snapshot is provided
+	query := Filter()
+	if snapshotName != "" {
+		snapshotID, err := c.GetVolumeGroupSnapshotIDCtx(ctx, snapshotName, httpTimeout)
+		if err != nil {
+			return nil, err
+		}
+		query.Field("source").Equals(SnapshotRef(snapshotID))
+	}
+
+	apiRequest, err := c.getAllCtx(ctx, "/snapshots", query, httpTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert the API Response (map[string]interface{}) to a struct
+	var apiResponse GetVolumeGroupSnapshotResponse
+	mapErr := decode(apiRequest, &apiResponse)
+	if mapErr != nil {
+		return nil, mapErr
+	}
+
+	views := []VolumeGroupSnapshot{}
+	for _, snapshot := range apiResponse.Hits {
+		if isSnapshotView(snapshot) {
+			views = append(views, snapshot)
+		}
+	}
+
+	return views, nil
+}
+
+// DeleteSnapshotView unmaps a view from every Host and Host Group and then deletes it from the Silk server.
+func (c *Credentials) DeleteSnapshotView(viewName string, timeout ...int) (*DeleteResponse, error) {
+	return c.DeleteSnapshotViewCtx(context.Background(), viewName, timeout...)
+}
+
+// DeleteSnapshotViewCtx is the context-aware form of DeleteSnapshotView.
+func (c *Credentials) DeleteSnapshotViewCtx(ctx context.Context, viewName string, timeout ...int) (*DeleteResponse, error) {
+
+	httpTimeout := httpTimeout(timeout)
+
+	view, err := c.lookupSnapshotView(ctx, viewName, httpTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := c.deleteSnapshotViewMappings(ctx, view, httpTimeout); err != nil {
+		return nil, err
+	}
+
+	apiRequest, err := c.DeleteCtx(ctx, fmt.Sprintf("/snapshots/%d", view.ID), httpTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert the API Response (map[string]interface{}) to a struct
+	var apiResponse DeleteResponse
+	mapErr := decode(apiRequest, &apiResponse)
+	if mapErr != nil {
+		return nil, mapErr
+	}
+
+	return &apiResponse, nil
+}
+
+// CreateHostSnapshotViewMapping maps a view to a Host. The returned mapping holds the LUN assigned by the Silk server.
+func (c *Credentials) CreateHostSnapshotViewMapping(hostName, viewName string, timeout ...int) (*CreateHostVolumeMappingResponse, error) {
+	return c.CreateHostSnapshotViewMappingCtx(context.Background(), hostName, viewName, timeout...)
+}
+
+// CreateHostSnapshotViewMappingCtx is the context-aware form of CreateHostSnapshotViewMapping.
+func (c *Credentials) CreateHostSnapshotViewMappingCtx(ctx context.Context, hostName, viewName string, timeout ...int) (*CreateHostVolumeMappingResponse, error) {
+
+	httpTimeout := httpTimeout(timeout)
+
+	var host Host
+	if err := c.lookupObject(ctx, KindHost, hostName, &host, httpTimeout); err != nil {
+		return nil, err
+	}
+
+	// Validates that the provided host is not part of a Host Group which would prevent the host being added.
+	if host.IsPartOfGroup == true {
+		return nil, fmt.Errorf("Host '%s' is a member of a Host Group and can not individually be mapped to a view", hostName)
+	}
+
+	return c.createSnapshotViewMapping(ctx, HostRef(host.ID), viewName, httpTimeout)
+}
+
+// CreateHostGroupSnapshotViewMapping maps a view to a Host Group. The returned mapping holds the LUN assigned by the
+// Silk server.
+func (c *Credentials) CreateHostGroupSnapshotViewMapping(hostGroupName, viewName string, timeout ...int) (*CreateHostVolumeMappingResponse, error) {
+	return c.CreateHostGroupSnapshotViewMappingCtx(context.Background(), hostGroupName, viewName, timeout...)
+}
+
+// CreateHostGroupSnapshotViewMappingCtx is the context-aware form of CreateHostGroupSnapshotViewMapping.
+func (c *Credentials) CreateHostGroupSnapshotViewMappingCtx(ctx context.Context, hostGroupName, viewName string, timeout ...int) (*CreateHostVolumeMappingResponse, error) {
+
+	httpTimeout := httpTimeout(timeout)
+
+	hostGroupID, err := c.GetHostGroupIDCtx(ctx, hostGroupName, httpTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.createSnapshotViewMapping(ctx, HostGroupRef(hostGroupID), viewName, httpTimeout)
+}
+
+// GetSnapshotViewMappings returns the Host and Host Group mappings of a view, including the LUN of each mapping.
+func (c *Credentials) GetSnapshotViewMappings(viewName string, timeout ...int) ([]IndividualHostMappingResponse, error) {
+	return c.GetSnapshotViewMappingsCtx(context.Background(), viewName, timeout...)
+}
+
+// GetSnapshotViewMappingsCtx is the context-aware form of GetSnapshotViewMappings.
+func (c *Credentials) GetSnapshotViewMappingsCtx(ctx context.Context, viewName string, timeout ...int) ([]IndividualHostMappingResponse, error) {
+
+	httpTimeout := httpTimeout(timeout)
+
+	view, err := c.lookupSnapshotView(ctx, viewName, httpTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.snapshotViewMappings(ctx, view, httpTimeout)
+}
+
+// DeleteSnapshotViewMappings unmaps a view from every Host and Host Group. The view itself is kept.
+func (c *Credentials) DeleteSnapshotViewMappings(viewName string, timeout ...int) (*DeleteResponse, error) {
+	return c.DeleteSnapshotViewMappingsCtx(context.Background(), viewName, timeout...)
+}
+
+// DeleteSnapshotViewMappingsCtx is the context-aware form of DeleteSnapshotViewMappings.
+func (c *Credentials) DeleteSnapshotViewMappingsCtx(ctx context.Context, viewName string, timeout ...int) (*DeleteResponse, error) {
+
+	httpTimeout := httpTimeout(timeout)
+
+	view, err := c.lookupSnapshotView(ctx, viewName, httpTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.deleteSnapshotViewMappings(ctx, view, httpTimeout)
+}
+
+// isSnapshotView reports whether the snapshot is a view, which is a snapshot created from another snapshot.
+func isSnapshotView(snapshot VolumeGroupSnapshot) bool {
+	return snapshot.Source.Kind() == KindVolumeGroupSnapshot
+}
+
+// lookupSnapshotView returns the view with the provided name.
+func (c *Credentials) lookupSnapshotView(ctx context.Context, viewName string, httpTimeout int) (*VolumeGroupSnapshot, error) {
+
+	var view VolumeGroupSnapshot
+	if err := c.lookupObject(ctx, KindVolumeGroupSnapshot, viewName, &view, httpTimeout); err != nil {
+		return nil, err
+	}
+
+	if isSnapshotView(view) == false {
+		return nil, fmt.Errorf("The Volume Group Snapshot '%s' is not a view", viewName)
+	}
+
+	return &view, nil
+}
+
+// createSnapshotViewMapping maps the view with the provided name to a Host or Host Group.
+func (c *Credentials) createSnapshotViewMapping(ctx context.Context, host Ref, viewName string, httpTimeout int) (*CreateHostVolumeMappingResponse, error) {
+
+	view, err := c.lookupSnapshotView(ctx, viewName, httpTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	config := map[string]interface{}{}
+	config["host"] = host
+	config["volume"] = SnapshotRef(view.ID)
+
+	apiRequest, err := c.PostCtx(ctx, "/mappings", config, httpTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert the API Response (map[string]interface{}) to a struct
+	var apiResponse CreateHostVolumeMappingResponse
+	mapErr := decode(apiRequest, &apiResponse)
+	if mapErr != nil {
+		return nil, mapErr
+	}
+
+	return &apiResponse, nil
+}
+
+// snapshotViewMappings returns the mappings of the provided view.
+func (c *Credentials) snapshotViewMappings(ctx context.Context, view *VolumeGroupSnapshot, httpTimeout int) ([]IndividualHostMappingResponse, error) {
+
+	apiRequest, err := c.getAllCtx(ctx, "/mappings", Filter().Field("volume").Equals(SnapshotRef(view.ID)), httpTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert the API Response (map[string]interface{}) to a struct
+	var apiResponse GetHostMappingsResponse
+	mapErr := decode(apiRequest, &apiResponse)
+	if mapErr != nil {
+		return nil, mapErr
+	}
+
+	mappings := []IndividualHostMappingResponse{}
+	if apiResponse.Hits != nil {
+		mappings = apiResponse.Hits
+	}
+
+	return mappings, nil
+}
+
+// deleteSnapshotViewMappings deletes every mapping of the provided view.
+func (c *Credentials) deleteSnapshotViewMappings(ctx context.Context, view *VolumeGroupSnapshot, httpTimeout int) (*DeleteResponse, error) {
+
+	mappings, err := c.snapshotViewMappings(ctx, view, httpTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, mapping := range mappings {
+		_, err := c.DeleteCtx(ctx, fmt.Sprintf("/mappings/%d", mapping.ID), httpTimeout)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &DeleteResponse{StatusCode: 204}, nil
+}
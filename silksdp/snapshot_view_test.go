@@ -0,0 +1,98 @@
+package silksdp
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func Test_SnapshotViews(t *testing.T) {
+	var created map[string]interface{}
+	var deleted []string
+	silk := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		hits := []interface{}{}
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/api/v2/snapshots":
+			json.NewDecoder(r.Body).Decode(&created)
+			json.NewEncoder(w).Encode(map[string]interface{}{"id": 9, "name": "vg01:view01", "source": created["source"]})
+			return
+		case r.Method == "POST" && r.URL.Path == "/api/v2/mappings":
+			json.NewEncoder(w).Encode(map[string]interface{}{"id": 40, "lun": 3, "host": map[string]interface{}{"ref": "/hosts/5"}, "volume": map[string]interface{}{"ref": "/snapshots/9"}})
+			return
+		case r.Method == "DELETE":
+			deleted = append(deleted, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		case r.URL.Path == "/api/v2/snapshots":
+			hits = append(hits,
+				map[string]interface{}{"id": 8, "name": "vg01:daily", "is_exposable": true, "retention_policy": map[string]interface{}{"ref": "/retention_policies/1"}, "source": map[string]interface{}{"ref": "/volume_groups/3"}},
+				map[string]interface{}{"id": 9, "name": "vg01:view01", "source": map[string]interface{}{"ref": "/snapshots/8"}},
+				map[string]interface{}{"id": 12, "name": "vg02:view02", "source": map[string]interface{}{"ref": "/snapshots/11"}},
+			)
+		case r.URL.Path == "/api/v2/hosts":
+			hits = append(hits, map[string]interface{}{"id": 5, "name": "proxy01"})
+		case r.URL.Path == "/api/v2/mappings":
+			hits = append(hits,
+				map[string]interface{}{"id": 40, "lun": 3, "host": map[string]interface{}{"ref": "/hosts/5"}, "volume": map[string]interface{}{"ref": "/snapshots/9"}},
+				map[string]interface{}{"id": 41, "lun": 1, "host": map[string]interface{}{"ref": "/hosts/5"}, "volume": map[string]interface{}{"ref": "/volumes/2"}},
+			)
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL)
+		}
+
+		// name__in, source, and volume filters are applied by the Silk server
+		for key, field := range map[string]string{"name__in": "name", "source": "source", "volume": "volume"} {
+			value := r.URL.Query().Get(key)
+			if value == "" {
+				continue
+			}
+			var filtered []interface{}
+			for _, hit := range hits {
+				switch fieldValue := hit.(map[string]interface{})[field].(type) {
+				case string:
+					if fieldValue == value {
+						filtered = append(filtered, hit)
+					}
+				case map[string]interface{}:
+					if fieldValue["ref"] == value {
+						filtered = append(filtered, hit)
+					}
+				}
+			}
+			hits = filtered
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"hits": hits, "total": len(hits)})
+	})
+
+	if _, err := silk.CreateSnapshotView("vg01:daily", "view01", nil); err != nil {
+		t.Fatalf("Failed to create the view: %v", err)
+	}
+	if created["short_name"] != "view01" || created["is_exposable"] != true || created["source"].(map[string]interface{})["ref"] != "/snapshots/8" || created["retention_policy"].(map[string]interface{})["ref"] != "/retention_policies/1" {
+		t.Errorf("Unexpected view request: %v", created)
+	}
+
+	views, err := silk.GetSnapshotViews("vg01:daily")
+	if err != nil || len(views) != 1 || views[0].ID != 9 {
+		t.Errorf("Expected the view of the snapshot, got %v (%v)", views, err)
+	}
+
+	mapping, err := silk.CreateHostSnapshotViewMapping("proxy01", "vg01:view01")
+	if err != nil || mapping.Lun != 3 {
+		t.Errorf("Expected the mapping to report LUN 3, got %v (%v)", mapping, err)
+	}
+
+	mappings, err := silk.GetSnapshotViewMappings("vg01:view01")
+	if err != nil || len(mappings) != 1 || mappings[0].ID != 40 {
+		t.Errorf("Expected only the mapping of the view, got %v (%v)", mappings, err)
+	}
+
+	if _, err := silk.DeleteSnapshotView("vg01:daily"); err == nil {
+		t.Errorf("Expected an error when deleting a snapshot that is not a view")
+	}
+	if _, err := silk.DeleteSnapshotView("vg01:view01"); err != nil {
+		t.Fatalf("Failed to delete the view: %v", err)
+	}
+	if len(deleted) != 2 || deleted[0] != "/api/v2/mappings/40" || deleted[1] != "/api/v2/snapshots/9" {
+		t.Errorf("Expected the mapping and then the view to be deleted, got %v", deleted)
+	}
+}
@@ -0,0 +1,231 @@
+package silksdp
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// StatsResolution is the interval between two samples of a statistics time series.
+type StatsResolution string
+
+// Resolutions supported by the Silk server. Finer resolutions are only kept for a short period of time.
+const (
+	Resolution5s StatsResolution = "5s"
+	Resolution1m StatsResolution = "1m"
+	Resolution1h StatsResolution = "1h"
+	Resolution1d StatsResolution = "1d"
+)
+
+// StatsWindow selects the samples returned by the GetXStats() functions. Fields left to their zero value are not sent,
+// in which case the Silk server returns its most recent samples at its default resolution.
+type StatsWindow struct {
+	From       time.Time
+	To         time.Time
+	Resolution StatsResolution
+}
+
+// IOStats holds the performance of the reads or writes of a single sample.
+type IOStats struct {
+	// IOPS is the average number of operations per second.
+	IOPS float64
+	// Throughput is the average amount of data transferred per second.
+	Throughput Capacity
+	// Latency is the average latency of an operation.
+	Latency time.Duration
+}
+
+// StatsSample holds the read and write performance measured at a point in time.
+type StatsSample struct {
+	Time  time.Time
+	Read  IOStats
+	Write IOStats
+}
+
+// Total returns the combined read and write performance of the sample. The latency is averaged over the operations.
+func (s StatsSample) Total() IOStats {
+
+	total := IOStats{
+		IOPS:       s.Read.IOPS + s.Write.IOPS,
+		Throughput: s.Read.Throughput + s.Write.Throughput,
+	}
+	if total.IOPS > 0 {
+		total.Latency = time.Duration((float64(s.Read.Latency)*s.Read.IOPS + float64(s.Write.Latency)*s.Write.IOPS) / total.IOPS)
+	}
+
+	return total
+}
+
+// StatsSeries is the statistics time series of the Silk server or of one of its objects, sorted from the oldest sample
+// to the most recent one.
+type StatsSeries struct {
+	// Object is a reference to the measured object. It is zero for the statistics of the whole Silk server.
+	Object  Ref
+	Samples []StatsSample
+}
+
+// rawStats is a single sample as returned by the Silk server for either the reads or the writes. Throughputs are in
+// KiB per second and latencies in microseconds.
+type rawStats struct {
+	Time        time.Time `mapstructure:"timestamp"`
+	IOPS        float64   `mapstructure:"iops_avg"`
+	Throughput  float64   `mapstructure:"throughput_avg"`
+	Latency     float64   `mapstructure:"latency_avg"`
+	Host        Ref       `mapstructure:"host"`
+	Volume      Ref       `mapstructure:"volume"`
+	VolumeGroup Ref       `mapstructure:"volume_group"`
+}
+
+// ioStats converts the sample into an IOStats.
+func (r rawStats) ioStats() IOStats {
+	return IOStats{
+		IOPS:       r.IOPS,
+		Throughput: Capacity(r.Throughput),
+		Latency:    time.Duration(r.Latency * float64(time.Microsecond)),
+	}
+}
+
+// object returns the reference to the object measured by the sample.
+func (r rawStats) object() Ref {
+	switch {
+	case r.Host.IsZero() == false:
+		return r.Host
+	case r.Volume.IsZero() == false:
+		return r.Volume
+	}
+	return r.VolumeGroup
+}
+
+// GetSystemStats returns the performance of the whole Silk server over the provided window.
+func (c *Credentials) GetSystemStats(window StatsWindow, timeout ...int) (*StatsSeries, error) {
+	return c.GetSystemStatsCtx(context.Background(), window, timeout...)
+}
+
+// GetSystemStatsCtx is the context-aware form of GetSystemStats.
+func (c *Credentials) GetSystemStatsCtx(ctx context.Context, window StatsWindow, timeout ...int) (*StatsSeries, error) {
+	return c.getStats(ctx, "/stats/system", "", Ref{}, window, httpTimeout(timeout))
+}
+
+// GetVolumeStats returns the performance of a Volume over the provided window.
+func (c *Credentials) GetVolumeStats(volumeName string, window StatsWindow, timeout ...int) (*StatsSeries, error) {
+	return c.GetVolumeStatsCtx(context.Background(), volumeName, window, timeout...)
+}
+
+// GetVolumeStatsCtx is the context-aware form of GetVolumeStats.
+func (c *Credentials) GetVolumeStatsCtx(ctx context.Context, volumeName string, window StatsWindow, timeout ...int) (*StatsSeries, error) {
+
+	httpTimeout := httpTimeout(timeout)
+
+	volumeID, err := c.GetVolumeIDCtx(ctx, volumeName, httpTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.getStats(ctx, "/stats/volumes", "volume", VolumeRef(volumeID), window, httpTimeout)
+}
+
+// GetVolumeGroupStats returns the performance of a Volume Group over the provided window.
+func (c *Credentials) GetVolumeGroupStats(volumeGroupName string, window StatsWindow, timeout ...int) (*StatsSeries, error) {
+	return c.GetVolumeGroupStatsCtx(context.Background(), volumeGroupName, window, timeout...)
+}
+
+// GetVolumeGroupStatsCtx is the context-aware form of GetVolumeGroupStats.
+func (c *Credentials) GetVolumeGroupStatsCtx(ctx context.Context, volumeGroupName string, window StatsWindow, timeout ...int) (*StatsSeries, error) {
+
+	httpTimeout := httpTimeout(timeout)
+
+	volumeGroupID, err := c.GetVolumeGroupIDCtx(ctx, volumeGroupName, httpTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.getStats(ctx, "/stats/volume_groups", "volume_group", VolumeGroupRef(volumeGroupID), window, httpTimeout)
+}
+
+// GetHostStats returns the performance seen by a Host over the provided window.
+func (c *Credentials) GetHostStats(hostName string, window StatsWindow, timeout ...int) (*StatsSeries, error) {
+	return c.GetHostStatsCtx(context.Background(), hostName, window, timeout...)
+}
+
+// GetHostStatsCtx is the context-aware form of GetHostStats.
+func (c *Credentials) GetHostStatsCtx(ctx context.Context, hostName string, window StatsWindow, timeout ...int) (*StatsSeries, error) {
+
+	httpTimeout := httpTimeout(timeout)
+
+	hostID, err := c.GetHostIDCtx(ctx, hostName, httpTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.getStats(ctx, "/stats/hosts", "host", HostRef(hostID), window, httpTimeout)
+}
+
+// getStats fetches the read and write samples of the object from the provided statistics collection and merges them
+// into a single time series. field is the name of the field referencing the object, empty for the system statistics.
+func (c *Credentials) getStats(ctx context.Context, collection, field string, object Ref, window StatsWindow, httpTimeout int) (*StatsSeries, error) {
+
+	if window.From.IsZero() == false && window.To.IsZero() == false && window.To.Before(window.From) {
+		return nil, fmt.Errorf("The end of the statistics window (%s) is before its start (%s)", window.To, window.From)
+	}
+
+	samples := map[int64]*StatsSample{}
+	for _, direction := range []string{"r", "w"} {
+		// Sort by timestamp so that samples added while paging land on the last page
+		query := Filter().Sort("timestamp")
+		query.Field("__rw").Equals(direction)
+		if field != "" {
+			query.Field(field).Equals(object)
+		}
+		if window.From.IsZero() == false {
+			query.Field("__from_time").Equals(window.From.Unix())
+		}
+		if window.To.IsZero() == false {
+			query.Field("__to_time").Equals(window.To.Unix())
+		}
+		if window.Resolution != "" {
+			query.Field("__resolution").Equals(window.Resolution)
+		}
+
+		apiRequest, err := c.getAllCtx(ctx, collection, query, httpTimeout)
+		if err != nil {
+			return nil, err
+		}
+
+		// Convert the API Response (map[string]interface{}) to a struct
+		var apiResponse struct {
+			Hits []rawStats `mapstructure:"hits"`
+		}
+		mapErr := decode(apiRequest, &apiResponse)
+		if mapErr != nil {
+			return nil, mapErr
+		}
+
+		for _, hit := range apiResponse.Hits {
+			// The object filter is enforced here as well in case the Silk server returns the samples of every object
+			if field != "" && hit.object() != object {
+				continue
+			}
+			sample, ok := samples[hit.Time.Unix()]
+			if ok == false {
+				sample = &StatsSample{Time: hit.Time}
+				samples[hit.Time.Unix()] = sample
+			}
+			if direction == "r" {
+				sample.Read = hit.ioStats()
+			} else {
+				sample.Write = hit.ioStats()
+			}
+		}
+	}
+
+	series := &StatsSeries{Object: object, Samples: []StatsSample{}}
+	for _, sample := range samples {
+		series.Samples = append(series.Samples, *sample)
+	}
+	sort.Slice(series.Samples, func(i, j int) bool {
+		return series.Samples[i].Time.Before(series.Samples[j].Time)
+	})
+
+	return series, nil
+}
@@ -0,0 +1,60 @@
+package silksdp
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func Test_GetVolumeStats(t *testing.T) {
+	silk := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		hits := []interface{}{}
+		switch r.URL.Path {
+		case "/api/v2/volumes":
+			hits = append(hits, map[string]interface{}{"id": 7, "name": "vol01"})
+		case "/api/v2/stats/volumes":
+			query := r.URL.Query()
+			if query.Get("volume") != "/volumes/7" || query.Get("__from_time") != "1600000000" || query.Get("__resolution") != "1m" || query.Get("__sort") != "timestamp" {
+				t.Errorf("Unexpected query: %s", r.URL.RawQuery)
+			}
+			if query.Get("__rw") == "r" {
+				hits = append(hits,
+					map[string]interface{}{"timestamp": 1600000060, "volume": map[string]interface{}{"ref": "/volumes/7"}, "iops_avg": 300, "throughput_avg": 3072, "latency_avg": 200},
+					map[string]interface{}{"timestamp": 1600000000, "volume": map[string]interface{}{"ref": "/volumes/7"}, "iops_avg": 100, "throughput_avg": 1024, "latency_avg": 400},
+					map[string]interface{}{"timestamp": 1600000000, "volume": map[string]interface{}{"ref": "/volumes/8"}, "iops_avg": 999, "throughput_avg": 999, "latency_avg": 999},
+				)
+			} else {
+				hits = append(hits,
+					map[string]interface{}{"timestamp": 1600000000, "volume": map[string]interface{}{"ref": "/volumes/7"}, "iops_avg": 300, "throughput_avg": 2048, "latency_avg": 800},
+				)
+			}
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL)
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"hits": hits, "total": len(hits)})
+	})
+
+	series, err := silk.GetVolumeStats("vol01", StatsWindow{From: time.Unix(1600000000, 0), Resolution: Resolution1m})
+	if err != nil {
+		t.Fatalf("Failed to get the volume statistics: %v", err)
+	}
+	if series.Object != VolumeRef(7) || len(series.Samples) != 2 {
+		t.Fatalf("Unexpected series: %+v", series)
+	}
+
+	first := series.Samples[0]
+	if first.Time.Unix() != 1600000000 || first.Read.IOPS != 100 || first.Write.Throughput != MiB(2) || first.Read.Latency != 400*time.Microsecond {
+		t.Errorf("Unexpected sample: %+v", first)
+	}
+
+	// (400µs * 100 + 800µs * 300) / 400 = 700µs
+	total := first.Total()
+	if total.IOPS != 400 || total.Throughput != MiB(3) || total.Latency != 700*time.Microsecond {
+		t.Errorf("Unexpected total: %+v", total)
+	}
+
+	if _, err := silk.GetSystemStats(StatsWindow{From: time.Unix(1600000060, 0), To: time.Unix(1600000000, 0)}); err == nil {
+		t.Errorf("Expected a window ending before its start to be refused")
+	}
+}
@@ -0,0 +1,136 @@
+package silksdp
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+// SystemOnline is the State reported by a Silk server, or by one of its nodes, that is fully operational.
+const SystemOnline = "online"
+
+// SystemHealth summarizes the state of the Silk server and of its nodes.
+type SystemHealth struct {
+	// State and SubState are the state of the Silk server.
+	State    string
+	SubState string
+	// Nodes holds every node of the Silk server, sorted by name.
+	Nodes []Node
+	// Healthy is set when the Silk server and every node are online.
+	Healthy bool
+	// Problems describes each component that is not online.
+	Problems []string
+}
+
+// GetSystem returns the model, software version, state, and capacity totals of the Silk server.
+func (c *Credentials) GetSystem(timeout ...int) (*System, error) {
+	return c.GetSystemCtx(context.Background(), timeout...)
+}
+
+// GetSystemCtx is the context-aware form of GetSystem.
+func (c *Credentials) GetSystemCtx(ctx context.Context, timeout ...int) (*System, error) {
+
+	httpTimeout := httpTimeout(timeout)
+
+	var system System
+	if err := c.getSingleton(ctx, "/system/state", &system, httpTimeout); err != nil {
+		return nil, err
+	}
+
+	if err := c.getSingleton(ctx, "/system/capacity", &system.Capacity, httpTimeout); err != nil {
+		return nil, err
+	}
+
+	return &system, nil
+}
+
+// GetSystemHealth returns the state of the Silk server and of each of its nodes.
+func (c *Credentials) GetSystemHealth(timeout ...int) (*SystemHealth, error) {
+	return c.GetSystemHealthCtx(context.Background(), timeout...)
+}
+
+// GetSystemHealthCtx is the context-aware form of GetSystemHealth.
+func (c *Credentials) GetSystemHealthCtx(ctx context.Context, timeout ...int) (*SystemHealth, error) {
+
+	httpTimeout := httpTimeout(timeout)
+
+	var system System
+	if err := c.getSingleton(ctx, "/system/state", &system, httpTimeout); err != nil {
+		return nil, err
+	}
+
+	apiRequest, err := c.getAllCtx(ctx, "/nodes", nil, httpTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert the API Response (map[string]interface{}) to a struct
+	var apiResponse struct {
+		Hits []Node `mapstructure:"hits"`
+	}
+	mapErr := decode(apiRequest, &apiResponse)
+	if mapErr != nil {
+		return nil, mapErr
+	}
+
+	health := &SystemHealth{State: system.State, SubState: system.SubState, Nodes: apiResponse.Hits, Problems: []string{}}
+	sort.Slice(health.Nodes, func(i, j int) bool {
+		return health.Nodes[i].Name < health.Nodes[j].Name
+	})
+
+	if system.State != SystemOnline {
+		health.Problems = append(health.Problems, fmt.Sprintf("The Silk server is %s (%s)", system.State, system.SubState))
+	}
+	for _, node := range health.Nodes {
+		if node.State != SystemOnline {
+			health.Problems = append(health.Problems, fmt.Sprintf("The node '%s' is %s", node.Name, node.State))
+		}
+	}
+	health.Healthy = len(health.Problems) == 0
+
+	return health, nil
+}
+
+// Ping checks that the Silk server can be reached and accepts the credentials. The returned error says which of the
+// two failed.
+func (c *Credentials) Ping(timeout ...int) error {
+	return c.PingCtx(context.Background(), timeout...)
+}
+
+// PingCtx is the context-aware form of Ping.
+func (c *Credentials) PingCtx(ctx context.Context, timeout ...int) error {
+
+	_, err := c.GetCtx(ctx, "/system/state", httpTimeout(timeout))
+	switch {
+	case err == nil:
+		return nil
+	case ctx.Err() != nil:
+		return err
+	case IsUnauthorized(err) || IsForbidden(err):
+		return fmt.Errorf("The Silk SDP server '%s' rejected the credentials of the user '%s': %w", c.Server, c.Username, err)
+	default:
+		return fmt.Errorf("Unable to reach the Silk SDP server '%s': %w", c.Server, err)
+	}
+}
+
+// getSingleton decodes the only object of a collection that always holds a single object (ex: /system/state).
+func (c *Credentials) getSingleton(ctx context.Context, collection string, output interface{}, httpTimeout int) error {
+
+	apiRequest, err := c.GetCtx(ctx, collection, httpTimeout)
+	if err != nil {
+		return err
+	}
+
+	var apiResponse struct {
+		Hits []interface{} `mapstructure:"hits"`
+	}
+	mapErr := decode(apiRequest, &apiResponse)
+	if mapErr != nil {
+		return mapErr
+	}
+	if len(apiResponse.Hits) == 0 {
+		return fmt.Errorf("The Silk SDP server returned an empty response for '%s'", collection)
+	}
+
+	return decode(apiResponse.Hits[0], output)
+}
@@ -0,0 +1,69 @@
+package silksdp
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func Test_GetSystem(t *testing.T) {
+	silk := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		hits := []interface{}{}
+		switch r.URL.Path {
+		case "/api/v2/system/state":
+			hits = append(hits, map[string]interface{}{"id": 1, "system_name": "silk01", "model": "K2", "system_version": "6.1.7", "state": "degraded", "sub_state": "node_down"})
+		case "/api/v2/system/capacity":
+			hits = append(hits, map[string]interface{}{"id": 1, "total": TiB(100), "allocated": TiB(40), "free": TiB(60), "state": "healthy"})
+		case "/api/v2/nodes":
+			hits = append(hits,
+				map[string]interface{}{"id": 2, "name": "cnode2", "state": "offline"},
+				map[string]interface{}{"id": 1, "name": "cnode1", "state": "online"},
+			)
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL)
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"hits": hits, "total": len(hits)})
+	})
+
+	system, err := silk.GetSystem()
+	if err != nil {
+		t.Fatalf("Failed to get the system: %v", err)
+	}
+	if system.Name != "silk01" || system.Version != "6.1.7" || system.Capacity.Total != TiB(100) || system.Capacity.Free != TiB(60) {
+		t.Errorf("Unexpected system: %+v", system)
+	}
+
+	health, err := silk.GetSystemHealth()
+	if err != nil {
+		t.Fatalf("Failed to get the system health: %v", err)
+	}
+	if health.Healthy || len(health.Problems) != 2 || health.Nodes[0].Name != "cnode1" {
+		t.Errorf("Unexpected system health: %+v", health)
+	}
+}
+
+func Test_Dial(t *testing.T) {
+	server, address := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/system/state" {
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL)
+		}
+		if username, _, _ := r.BasicAuth(); username != "admin" {
+			w.WriteHeader(http.StatusUnauthorized)
+			json.NewEncoder(w).Encode(map[string]interface{}{"error_msg": "Authentication failed"})
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"hits": []interface{}{map[string]interface{}{"id": 1, "state": "online"}}, "total": 1})
+	})
+	defer server.Close()
+
+	if _, err := Dial(context.Background(), address, "admin", "password", WithHTTPClient(server.Client())); err != nil {
+		t.Errorf("Expected Dial to succeed, got %v", err)
+	}
+
+	_, err := Dial(context.Background(), address, "operator", "password", WithHTTPClient(server.Client()))
+	if err == nil || IsUnauthorized(err) == false || strings.Contains(err.Error(), "rejected the credentials") == false {
+		t.Errorf("Expected Dial to report the rejected credentials, got %v", err)
+	}
+}
@@ -1,157 +1,110 @@
 package silksdp
 
-type (
-	// GetVolumeGroupsResponse holds the response of the GetVolumeGroups() function
-	GetVolumeGroupsResponse struct {
-		Hits []struct {
-			CapacityPolicy             interface{} `mapstructure:"capacity_policy"`
-			CapacityState              string      `mapstructure:"capacity_state"`
-			CreationTime               float64     `mapstructure:"creation_time"`
-			Description                interface{} `mapstructure:"description"`
-			ID                         int         `mapstructure:"id"`
-			IsDedup                    bool        `mapstructure:"is_dedup"`
-			IsDefault                  bool        `mapstructure:"is_default"`
-			IscsiTgtConvertedName      string      `mapstructure:"iscsi_tgt_converted_name"`
-			LastRestoredFrom           interface{} `mapstructure:"last_restored_from"`
-			LastRestoredTime           interface{} `mapstructure:"last_restored_time"`
-			LastSnapshotCreationTime   int         `mapstructure:"last_snapshot_creation_time"`
-			LogicalCapacity            float64     `mapstructure:"logical_capacity"`
-			MappedHostsCount           int         `mapstructure:"mapped_hosts_count"`
-			Name                       string      `mapstructure:"name"`
-			Quota                      interface{} `mapstructure:"quota"`
-			ReplicationPeerVolumeGroup interface{} `mapstructure:"replication_peer_volume_group"`
-			ReplicationRpoHistory      interface{} `mapstructure:"replication_rpo_history"`
-			ReplicationSession         interface{} `mapstructure:"replication_session"`
-			SnapshotsCount             int         `mapstructure:"snapshots_count"`
-			SnapshotsLogicalCapacity   int         `mapstructure:"snapshots_logical_capacity"`
-			SnapshotsOverheadState     string      `mapstructure:"snapshots_overhead_state"`
-			ViewsCount                 int         `mapstructure:"views_count"`
-			VolumesCount               int         `mapstructure:"volumes_count"`
-			VolumesLogicalCapacity     int         `mapstructure:"volumes_logical_capacity"`
-			VolumesProvisionedCapacity int64       `mapstructure:"volumes_provisioned_capacity"`
-		} `mapstructure:"hits"`
-		Limit  int `mapstructure:"limit"`
-		Offset int `mapstructure:"offset"`
-		Total  int `mapstructure:"total"`
-	}
+import "time"
 
-	// CreateOrUpdateVolumeGroupResponse holds the response of the CreateVolumeGroup() and
-	// UpdateVolumeGroup() functions
-	CreateOrUpdateVolumeGroupResponse struct {
-		CapacityPolicy             interface{} `mapstructure:"capacity_policy"`
+// The types below model the objects returned by the Silk server. Each object has a single type that is shared by the
+// Get, List, Create, and Update functions. Fields that the Silk server may return as null are pointers, references to
+// other objects are Refs (a zero Ref means no object is referenced), and epoch timestamps are decoded into time.Time.
+type (
+	// VolumeGroup is a single Volume Group as returned by GetVolumeGroups() and IterateVolumeGroups()
+	VolumeGroup struct {
+		CapacityPolicy             Ref         `mapstructure:"capacity_policy"`
 		CapacityState              string      `mapstructure:"capacity_state"`
-		CreationTime               int         `mapstructure:"creation_time"`
-		Description                interface{} `mapstructure:"description"`
+		CreationTime               time.Time   `mapstructure:"creation_time"`
+		Description                *string     `mapstructure:"description"`
 		ID                         int         `mapstructure:"id"`
 		IsDedup                    bool        `mapstructure:"is_dedup"`
 		IsDefault                  bool        `mapstructure:"is_default"`
-		LastRestoredFrom           interface{} `mapstructure:"last_restored_from"`
-		LastRestoredTime           interface{} `mapstructure:"last_restored_time"`
-		LastSnapshotCreationTime   int         `mapstructure:"last_snapshot_creation_time"`
-		LogicalCapacity            int         `mapstructure:"logical_capacity"`
+		IscsiTgtConvertedName      string      `mapstructure:"iscsi_tgt_converted_name"`
+		LastRestoredFrom           Ref         `mapstructure:"last_restored_from"`
+		LastRestoredTime           *time.Time  `mapstructure:"last_restored_time"`
+		LastSnapshotCreationTime   *time.Time  `mapstructure:"last_snapshot_creation_time"`
+		LogicalCapacity            Capacity    `mapstructure:"logical_capacity"`
 		MappedHostsCount           int         `mapstructure:"mapped_hosts_count"`
 		Name                       string      `mapstructure:"name"`
-		Quota                      interface{} `mapstructure:"quota"`
-		ReplicationPeerVolumeGroup interface{} `mapstructure:"replication_peer_volume_group"`
-		ReplicationRpoHistory      interface{} `mapstructure:"replication_rpo_history"`
-		ReplicationSession         interface{} `mapstructure:"replication_session"`
+		QoSPolicy                  Ref         `mapstructure:"qos_policy"`
+		Quota                      Capacity    `mapstructure:"quota"` // 0 when the Volume Group has no quota
+		ReplicationPeerVolumeGroup Ref         `mapstructure:"replication_peer_volume_group"`
+		ReplicationRpoHistory      []RPOSample `mapstructure:"replication_rpo_history"`
+		ReplicationSession         Ref         `mapstructure:"replication_session"`
 		SnapshotsCount             int         `mapstructure:"snapshots_count"`
-		SnapshotsLogicalCapacity   int         `mapstructure:"snapshots_logical_capacity"`
+		SnapshotsLogicalCapacity   Capacity    `mapstructure:"snapshots_logical_capacity"`
 		SnapshotsOverheadState     string      `mapstructure:"snapshots_overhead_state"`
 		ViewsCount                 int         `mapstructure:"views_count"`
 		VolumesCount               int         `mapstructure:"volumes_count"`
-		VolumesLogicalCapacity     int         `mapstructure:"volumes_logical_capacity"`
-		VolumesProvisionedCapacity int         `mapstructure:"volumes_provisioned_capacity"`
+		VolumesLogicalCapacity     Capacity    `mapstructure:"volumes_logical_capacity"`
+		VolumesProvisionedCapacity Capacity    `mapstructure:"volumes_provisioned_capacity"`
 		PipeID                     int         `mapstructure:"pipeId"`
 		PipeName                   string      `mapstructure:"pipeName"`
 	}
 
+	// RPOSample is a single measure of the replication lag of a Volume Group, as found in its ReplicationRpoHistory
+	RPOSample struct {
+		Lag  time.Duration `mapstructure:"rpo"`
+		Time time.Time     `mapstructure:"timestamp"`
+	}
+
+	// GetVolumeGroupsResponse holds the response of the GetVolumeGroups() function
+	GetVolumeGroupsResponse struct {
+		Hits   []VolumeGroup `mapstructure:"hits"`
+		Limit  int           `mapstructure:"limit"`
+		Offset int           `mapstructure:"offset"`
+		Total  int           `mapstructure:"total"`
+	}
+
+	// CreateOrUpdateVolumeGroupResponse holds the response of the CreateVolumeGroup() and
+	// UpdateVolumeGroup() functions
+	CreateOrUpdateVolumeGroupResponse = VolumeGroup
+
+	// Volume is a single Volume as returned by GetVolumes() and IterateVolumes()
+	Volume struct {
+		AvgCompressedRatio             float64    `mapstructure:"avg_compressed_ratio"`
+		AvgCompressedRatioTimestamp    *time.Time `mapstructure:"avg_compressed_ratio_timestamp"`
+		CreationTime                   time.Time  `mapstructure:"creation_time"`
+		CurrentReplicationStats        Ref        `mapstructure:"current_replication_stats"`
+		CurrentStats                   Ref        `mapstructure:"current_stats"` // Use GetVolumeStats() for the statistics time series
+		DedupSource                    int        `mapstructure:"dedup_source"`
+		DedupTarget                    int        `mapstructure:"dedup_target"`
+		Description                    *string    `mapstructure:"description"`
+		ID                             int        `mapstructure:"id"`
+		IsDedup                        bool       `mapstructure:"is_dedup"`
+		IsNew                          bool       `mapstructure:"is_new"`
+		LastRestoredFrom               Ref        `mapstructure:"last_restored_from"`
+		LastRestoredTime               *time.Time `mapstructure:"last_restored_time"`
+		LogicalCapacity                Capacity   `mapstructure:"logical_capacity"`
+		MarkedForDeletion              bool       `mapstructure:"marked_for_deletion"`
+		Name                           string     `mapstructure:"name"`
+		NoDedup                        int        `mapstructure:"no_dedup"`
+		NodeID                         int        `mapstructure:"node_id"`
+		QoSPolicy                      Ref        `mapstructure:"qos_policy"`
+		ReadOnly                       bool       `mapstructure:"read_only"`
+		ReplicationPeerVolume          Ref        `mapstructure:"replication_peer_volume"`
+		ScsiSn                         string     `mapstructure:"scsi_sn"`
+		ScsiSuffix                     int        `mapstructure:"scsi_suffix"`
+		Size                           Capacity   `mapstructure:"size"`
+		SnapshotsLogicalCapacity       Capacity   `mapstructure:"snapshots_logical_capacity"`
+		StreamAvgCompressedSizeInBytes int        `mapstructure:"stream_avg_compressed_size_in_bytes"`
+		VmwareSupport                  bool       `mapstructure:"vmware_support"`
+		VolumeGroup                    Ref        `mapstructure:"volume_group"`
+		PipeID                         int        `mapstructure:"pipeId"`
+		PipeName                       string     `mapstructure:"pipeName"`
+	}
+
 	// CreateOrUpdateVolumeResponse holds the response of the CreateVolume() and
 	// UpdateVolume() functions
-	CreateOrUpdateVolumeResponse struct {
-		AvgCompressedRatio          float64     `mapstructure:"avg_compressed_ratio"`
-		AvgCompressedRatioTimestamp int         `mapstructure:"avg_compressed_ratio_timestamp"`
-		CreationTime                int         `mapstructure:"creation_time"`
-		CurrentReplicationStats     interface{} `mapstructure:"current_replication_stats"`
-		CurrentStats                struct {
-			Ref string `mapstructure:"ref"`
-		} `mapstructure:"current_stats"`
-		DedupSource                    int         `mapstructure:"dedup_source"`
-		DedupTarget                    int         `mapstructure:"dedup_target"`
-		Description                    interface{} `mapstructure:"description"`
-		ID                             int         `mapstructure:"id"`
-		IsDedup                        bool        `mapstructure:"is_dedup"`
-		IsNew                          bool        `mapstructure:"is_new"`
-		LastRestoredFrom               interface{} `mapstructure:"last_restored_from"`
-		LastRestoredTime               interface{} `mapstructure:"last_restored_time"`
-		LogicalCapacity                int         `mapstructure:"logical_capacity"`
-		MarkedForDeletion              bool        `mapstructure:"marked_for_deletion"`
-		Name                           string      `mapstructure:"name"`
-		NoDedup                        int         `mapstructure:"no_dedup"`
-		NodeID                         int         `mapstructure:"node_id"`
-		ReadOnly                       bool        `mapstructure:"read_only"`
-		ReplicationPeerVolume          string      `mapstructure:"replication_peer_volume"`
-		ScsiSn                         string      `mapstructure:"scsi_sn"`
-		ScsiSuffix                     int         `mapstructure:"scsi_suffix"`
-		Size                           int         `mapstructure:"size"`
-		SnapshotsLogicalCapacity       int         `mapstructure:"snapshots_logical_capacity"`
-		StreamAvgCompressedSizeInBytes int         `mapstructure:"stream_avg_compressed_size_in_bytes"`
-		VmwareSupport                  bool        `mapstructure:"vmware_support"`
-		VolumeGroup                    struct {
-			Ref string `mapstructure:"ref"`
-		} `mapstructure:"volume_group"`
-		PipeID   int    `mapstructure:"pipeId"`
-		PipeName string `mapstructure:"pipeName"`
-	}
+	CreateOrUpdateVolumeResponse = Volume
 
 	// GetVolumesResponse holds the response of the GetVolumes() function
 	GetVolumesResponse struct {
-		Hits []struct {
-			AvgCompressedRatio          int         `mapstructure:"avg_compressed_ratio"`
-			AvgCompressedRatioTimestamp float64     `mapstructure:"avg_compressed_ratio_timestamp"`
-			CreationTime                int         `mapstructure:"creation_time"`
-			CurrentReplicationStats     interface{} `mapstructure:"current_replication_stats"`
-			CurrentStats                struct {
-				Ref string `mapstructure:"ref"`
-			} `mapstructure:"current_stats"`
-			DedupSource           int         `mapstructure:"dedup_source"`
-			DedupTarget           int         `mapstructure:"dedup_target"`
-			Description           interface{} `mapstructure:"description"`
-			ID                    int         `mapstructure:"id"`
-			IsDedup               bool        `mapstructure:"is_dedup"`
-			IsNew                 bool        `mapstructure:"is_new"`
-			LastRestoredFrom      interface{} `mapstructure:"last_restored_from"`
-			LastRestoredTime      interface{} `mapstructure:"last_restored_time"`
-			LogicalCapacity       int         `mapstructure:"logical_capacity"`
-			MarkedForDeletion     bool        `mapstructure:"marked_for_deletion"`
-			Name                  string      `mapstructure:"name"`
-			NoDedup               int         `mapstructure:"no_dedup"`
-			NodeID                int         `mapstructure:"node_id"`
-			ReadOnly              bool        `mapstructure:"read_only"`
-			ReplicationPeerVolume struct {
-				Ref string `mapstructure:"ref"`
-			} `mapstructure:"replication_peer_volume"`
-			ScsiSn                         string `mapstructure:"scsi_sn"`
-			ScsiSuffix                     int    `mapstructure:"scsi_suffix"`
-			Size                           int    `mapstructure:"size"`
-			SnapshotsLogicalCapacity       int    `mapstructure:"snapshots_logical_capacity"`
-			StreamAvgCompressedSizeInBytes int    `mapstructure:"stream_avg_compressed_size_in_bytes"`
-			VmwareSupport                  bool   `mapstructure:"vmware_support"`
-			VolumeGroup                    struct {
-				Ref string `mapstructure:"ref"`
-			} `mapstructure:"volume_group"`
-		} `mapstructure:"hits"`
-		Limit  int `mapstructure:"limit"`
-		Offset int `mapstructure:"offset"`
-		Total  int `mapstructure:"total"`
+		Hits   []Volume `mapstructure:"hits"`
+		Limit  int      `mapstructure:"limit"`
+		Offset int      `mapstructure:"offset"`
+		Total  int      `mapstructure:"total"`
 	}
 
-	// CreateOrUpdateHostResponse holds the response of the CreateHost() and
-	// UpdateHost() functions
-	CreateOrUpdateHostResponse struct {
-		HostGroup struct {
-			Ref string `mapstructure:"ref"`
-		} `mapstructure:"host_group"`
+	// Host is a single Host as returned by GetHosts() and IterateHosts()
+	Host struct {
+		HostGroup     Ref    `mapstructure:"host_group"`
 		ID            int    `mapstructure:"id"`
 		IsPartOfGroup bool   `mapstructure:"is_part_of_group"`
 		Name          string `mapstructure:"name"`
@@ -160,273 +113,276 @@ type (
 		VolumesCount  int    `mapstructure:"volumes_count"`
 	}
 
+	// CreateOrUpdateHostResponse holds the response of the CreateHost() and
+	// UpdateHost() functions
+	CreateOrUpdateHostResponse = Host
+
 	// GetHostsResponse holds the response of the GetHosts() function
 	GetHostsResponse struct {
-		Hits []struct {
-			HostGroup struct {
-				Ref string `mapstructure:"ref"`
-			} `mapstructure:"host_group"`
-			ID            int    `mapstructure:"id"`
-			IsPartOfGroup bool   `mapstructure:"is_part_of_group"`
-			Name          string `mapstructure:"name"`
-			Type          string `mapstructure:"type"`
-			ViewsCount    int    `mapstructure:"views_count"`
-			VolumesCount  int    `mapstructure:"volumes_count"`
-		} `mapstructure:"hits"`
-		Limit  int `mapstructure:"limit"`
-		Offset int `mapstructure:"offset"`
-		Total  int `mapstructure:"total"`
+		Hits   []Host `mapstructure:"hits"`
+		Limit  int    `mapstructure:"limit"`
+		Offset int    `mapstructure:"offset"`
+		Total  int    `mapstructure:"total"`
+	}
+
+	// HostGroup is a single Host Group as returned by GetHostGroups() and IterateHostGroups()
+	HostGroup struct {
+		AllowDifferentHostTypes bool    `mapstructure:"allow_different_host_types"`
+		Description             *string `mapstructure:"description"`
+		HostsCount              int     `mapstructure:"hosts_count"`
+		ID                      int     `mapstructure:"id"`
+		Name                    string  `mapstructure:"name"`
+		ViewsCount              int     `mapstructure:"views_count"`
+		VolumesCount            int     `mapstructure:"volumes_count"`
 	}
 
 	// CreateOrUpdateHostGroupResponse holds the response of the CreateHostGroup() and
 	// UpdateHostGroup() functions
-	CreateOrUpdateHostGroupResponse struct {
-		AllowDifferentHostTypes bool        `mapstructure:"allow_different_host_types"`
-		Description             interface{} `mapstructure:"description"`
-		HostsCount              int         `mapstructure:"hosts_count"`
-		ID                      int         `mapstructure:"id"`
-		Name                    string      `mapstructure:"name"`
-		ViewsCount              int         `mapstructure:"views_count"`
-		VolumesCount            int         `mapstructure:"volumes_count"`
-	}
+	CreateOrUpdateHostGroupResponse = HostGroup
 
 	// GetHostGroupsResponse holds the response of the GetHostGroups() function
 	GetHostGroupsResponse struct {
-		Hits []struct {
-			AllowDifferentHostTypes bool        `mapstructure:"allow_different_host_types"`
-			Description             interface{} `mapstructure:"description"`
-			HostsCount              int         `mapstructure:"hosts_count"`
-			ID                      int         `mapstructure:"id"`
-			Name                    string      `mapstructure:"name"`
-			ViewsCount              int         `mapstructure:"views_count"`
-			VolumesCount            int         `mapstructure:"volumes_count"`
-		} `mapstructure:"hits"`
-		Limit  int `mapstructure:"limit"`
-		Offset int `mapstructure:"offset"`
-		Total  int `mapstructure:"total"`
+		Hits   []HostGroup `mapstructure:"hits"`
+		Limit  int         `mapstructure:"limit"`
+		Offset int         `mapstructure:"offset"`
+		Total  int         `mapstructure:"total"`
 	}
 
 	// CreateHostVolumeMappingResponse holds the response of the CreateHostVolumeMapping() function
-	CreateHostVolumeMappingResponse struct {
-		Host struct {
-			Ref string `mapstructure:"ref"`
-		} `mapstructure:"host"`
-		ID     int `mapstructure:"id"`
-		Lun    int `mapstructure:"lun"`
-		Volume struct {
-			Ref string `mapstructure:"ref"`
-		} `mapstructure:"volume"`
-	}
+	CreateHostVolumeMappingResponse = IndividualHostMappingResponse
 
 	// GetHostMappingsResponse holds the response of the GET /mappings API call used inside the GetHostMappings()
 	// function. This value is then filtered to returned the "Hits" responses in IndividualHostMappingResponse
 	GetHostMappingsResponse struct {
-		Hits []struct {
-			Host struct {
-				Ref string `mapstructure:"ref"`
-			} `mapstructure:"host"`
-			ID     int `mapstructure:"id"`
-			Lun    int `mapstructure:"lun,omitempty"`
-			Volume struct {
-				Ref string `mapstructure:"ref"`
-			} `mapstructure:"volume"`
-		} `mapstructure:"hits"`
-		Limit  int `mapstructure:"limit"`
-		Offset int `mapstructure:"offset"`
-		Total  int `mapstructure:"total"`
+		Hits   []IndividualHostMappingResponse `mapstructure:"hits"`
+		Limit  int                             `mapstructure:"limit"`
+		Offset int                             `mapstructure:"offset"`
+		Total  int                             `mapstructure:"total"`
 	}
 
 	// IndividualHostMappingResponse holds Host mappings returned by the GetHostMappingsResponse() function
 	IndividualHostMappingResponse struct {
-		Host struct {
-			Ref string "mapstructure:\"ref\""
-		} "mapstructure:\"host\""
-		ID     int "mapstructure:\"id\""
-		Lun    int "mapstructure:\"lun,omitempty\""
-		Volume struct {
-			Ref string "mapstructure:\"ref\""
-		} "mapstructure:\"volume\""
+		Host   Ref `mapstructure:"host"`
+		ID     int `mapstructure:"id"`
+		Lun    int `mapstructure:"lun,omitempty"`
+		Volume Ref `mapstructure:"volume"`
 	}
 
 	// GetHostPWWNResponse holds the response of the GET /host_fc_ports API call used inside the GetHostPWWN()
 	// function. This value is then filtered to returned the "Hits" responses in IndividualHostPWWNResponse
 	GetHostPWWNResponse struct {
-		Hits []struct {
-			Host struct {
-				Ref string `mapstructure:"ref"`
-			} `mapstructure:"host"`
-			ID   int    `mapstructure:"id"`
-			Pwwn string `mapstructure:"pwwn"`
-		} `mapstructure:"hits"`
-		Limit  int `mapstructure:"limit"`
-		Offset int `mapstructure:"offset"`
-		Total  int `mapstructure:"total"`
+		Hits   []IndividualHostPWWNResponse `mapstructure:"hits"`
+		Limit  int                          `mapstructure:"limit"`
+		Offset int                          `mapstructure:"offset"`
+		Total  int                          `mapstructure:"total"`
 	}
 
 	// IndividualHostPWWNResponse holds the PWWN and Host mappings returned by the GetHostPWWN() function
 	IndividualHostPWWNResponse struct {
-		Host struct {
-			Ref string `mapstructure:"ref"`
-		} `mapstructure:"host"`
+		Host Ref    `mapstructure:"host"`
 		ID   int    `mapstructure:"id"`
 		Pwwn string `mapstructure:"pwwn"`
 	}
 
 	// CreateHostPWWNResponse holds the response of the CreateHostPWWN() function
-	CreateHostPWWNResponse struct {
-		Host struct {
-			Ref string `mapstructure:"ref"`
-		} `mapstructure:"host"`
-		ID   int    `mapstructure:"id"`
-		Pwwn string `mapstructure:"pwwn"`
-	}
+	CreateHostPWWNResponse = IndividualHostPWWNResponse
 
 	// GetHostIQNResponse holds the response of the GET /host_iqns API call used inside the GetHostIQN()
 	// function. This value is then filtered to returned the "Hits" responses in IndividualHostIQNResponse
 	GetHostIQNResponse struct {
-		Hits []struct {
-			Host struct {
-				Ref string `mapstructure:"ref"`
-			} `mapstructure:"host"`
-			ID  int    `mapstructure:"id"`
-			Iqn string `mapstructure:"iqn"`
-		} `mapstructure:"hits"`
-		Limit  int `mapstructure:"limit"`
-		Offset int `mapstructure:"offset"`
-		Total  int `mapstructure:"total"`
+		Hits   []IndividualHostIQNResponse `mapstructure:"hits"`
+		Limit  int                         `mapstructure:"limit"`
+		Offset int                         `mapstructure:"offset"`
+		Total  int                         `mapstructure:"total"`
 	}
 
 	// IndividualHostIQNResponse holds the IQN and Host mappings returned by the GetHostIQN() function
 	IndividualHostIQNResponse struct {
-		Host struct {
-			Ref string `mapstructure:"ref"`
-		} `mapstructure:"host"`
-		ID  int    `mapstructure:"id"`
-		Iqn string `mapstructure:"iqn"`
+		Host Ref    `mapstructure:"host"`
+		ID   int    `mapstructure:"id"`
+		Iqn  string `mapstructure:"iqn"`
 	}
 
 	// CreateHostIQNResponse holds the response of the CreateHostIQN() function
-	CreateHostIQNResponse struct {
-		Host struct {
-			Ref string `mapstructure:"ref"`
-		} `mapstructure:"host"`
-		ID  int    `mapstructure:"id"`
-		Iqn string `mapstructure:"iqn"`
-	}
+	CreateHostIQNResponse = IndividualHostIQNResponse
 
-	// GetCapacityPolicyResponse holds the response of the GetCapacityPolicyName() function
-	GetCapacityPolicyResponse struct {
-		Hits []struct {
-			CriticalThreshold         int    `mapstructure:"critical_threshold"`
-			ErrorThreshold            int    `mapstructure:"error_threshold"`
-			FullThreshold             int    `mapstructure:"full_threshold"`
-			ID                        int    `mapstructure:"id"`
-			IsDefault                 bool   `mapstructure:"is_default"`
-			Name                      string `mapstructure:"name"`
-			NumSnapshots              int    `mapstructure:"num_snapshots"`
-			SnapshotOverheadThreshold int    `mapstructure:"snapshot_overhead_threshold"`
-			WarningThreshold          int    `mapstructure:"warning_threshold"`
-		} `mapstructure:"hits"`
-		Limit  int `mapstructure:"limit"`
-		Offset int `mapstructure:"offset"`
-		Total  int `mapstructure:"total"`
+	// CapacityPolicy is a single Volume Group Capacity Policy as returned by GetCapacityPolicy()
+	CapacityPolicy struct {
+		CriticalThreshold         int    `mapstructure:"critical_threshold"`
+		ErrorThreshold            int    `mapstructure:"error_threshold"`
+		FullThreshold             int    `mapstructure:"full_threshold"`
+		ID                        int    `mapstructure:"id"`
+		IsDefault                 bool   `mapstructure:"is_default"`
+		Name                      string `mapstructure:"name"`
+		NumSnapshots              int    `mapstructure:"num_snapshots"`
+		SnapshotOverheadThreshold int    `mapstructure:"snapshot_overhead_threshold"`
+		WarningThreshold          int    `mapstructure:"warning_threshold"`
 	}
 
-	/* GetCapacityPolicyResponse placeholder
-
+	// GetCapacityPolicyResponse holds the response of the GetCapacityPolicyName() function
 	GetCapacityPolicyResponse struct {
-		Hits []struct {
-			CriticalThreshold         int    `json:"critical_threshold"`
-			ErrorThreshold            int    `json:"error_threshold"`
-			FullThreshold             int    `json:"full_threshold"`
-			ID                        int    `json:"id"`
-			IsDefault                 bool   `json:"is_default"`
-			Name                      string `json:"name"`
-			NumSnapshots              int    `json:"num_snapshots"`
-			SnapshotOverheadThreshold int    `json:"snapshot_overhead_threshold"`
-			WarningThreshold          int    `json:"warning_threshold"`
-		} `json:"hits"`
-		Limit  int `json:"limit"`
-		Offset int `json:"offset"`
-		Total  int `json:"total"`
+		Hits   []CapacityPolicy `mapstructure:"hits"`
+		Limit  int              `mapstructure:"limit"`
+		Offset int              `mapstructure:"offset"`
+		Total  int              `mapstructure:"total"`
 	}
-	*/
 
 	// CreateOrUpdateCapacityPolicyResponse holds the response for creating a capacity policy
-	CreateOrUpdateCapacityPolicyResponse struct {
-		Name                      string `mapstructure:"name"`
-		WarningThreshold          int    `mapstructure:"warning_threshold"`
-		ErrorThreshold            int    `mapstructure:"error_threshold"`
-		CriticalThreshold         int    `mapstructure:"critical_threshold"`
-		FullThreshold             int    `mapstructure:"full_threshold"`
-		SnapshotOverheadThreshold int    `mapstructure:"snapshot_overhead_threshold"`
-		ID                        int    `mapstructure:"id"`
+	CreateOrUpdateCapacityPolicyResponse = CapacityPolicy
+
+	// RetentionPolicy is a single Retention Policy as returned by GetRetentionPolicy()
+	RetentionPolicy struct {
+		Days                int    `mapstructure:"days"`
+		Hours               int    `mapstructure:"hours"`
+		ID                  int    `mapstructure:"id"`
+		Name                string `mapstructure:"name"`
+		NumSnapshots        int    `mapstructure:"num_snapshots"`
+		SnapshotsUsageCount int    `mapstructure:"snapshots_usage_count"`
+		Weeks               int    `mapstructure:"weeks"`
 	}
 
 	// GetRetentionPolicyResponse holds the response for GetRetentionPolicy() function
 	GetRetentionPolicyResponse struct {
-		Hits []struct {
-			Days                int    `mapstructure:"days"`
-			Hours               int    `mapstructure:"hours"`
-			ID                  int    `mapstructure:"id"`
-			Name                string `mapstructure:"name"`
-			NumSnapshots        int    `mapstructure:"num_snapshots"`
-			SnapshotsUsageCount int    `mapstructure:"snapshots_usage_count"`
-			Weeks               int    `mapstructure:"weeks"`
-		} `mapstructure:"hits"`
-		Limit  int `mapstructure:"limit"`
-		Offset int `mapstructure:"offset"`
-		Total  int `mapstructure:"total"`
+		Hits   []RetentionPolicy `mapstructure:"hits"`
+		Limit  int               `mapstructure:"limit"`
+		Offset int               `mapstructure:"offset"`
+		Total  int               `mapstructure:"total"`
 	}
 
 	// CreateOrUpdateRetentionPolicyResponse holds the data clause for CreateRetentionPolicy() function
-	CreateOrUpdateRetentionPolicyResponse struct {
-		Name         string      `mapstructure:"name"`
-		NumSnapshots interface{} `mapstructure:"num_snapshots"`
-		Weeks        interface{} `mapstructure:"weeks"`
-		Days         interface{} `mapstructure:"days"`
-		Hours        interface{} `mapstructure:"hours"`
-		ID           int         `mapstructure:"id"`
+	CreateOrUpdateRetentionPolicyResponse = RetentionPolicy
+
+	// QoSPolicy is a single QoS Policy as returned by GetQoSPolicy(). Bandwidths are in MB/s and a limit or guarantee
+	// of 0 is not enforced.
+	QoSPolicy struct {
+		ID           int    `mapstructure:"id"`
+		IsDefault    bool   `mapstructure:"is_default"`
+		MaxBandwidth int    `mapstructure:"max_bw"`
+		MaxIOPS      int    `mapstructure:"max_iops"`
+		MinBandwidth int    `mapstructure:"min_bw"`
+		MinIOPS      int    `mapstructure:"min_iops"`
+		Name         string `mapstructure:"name"`
+	}
+
+	// GetQoSPolicyResponse holds the response of the GetQoSPolicy() function
+	GetQoSPolicyResponse struct {
+		Hits   []QoSPolicy `mapstructure:"hits"`
+		Limit  int         `mapstructure:"limit"`
+		Offset int         `mapstructure:"offset"`
+		Total  int         `mapstructure:"total"`
+	}
+
+	// CreateOrUpdateQoSPolicyResponse holds the response of the CreateQoSPolicy() and UpdateQoSPolicy() functions
+	CreateOrUpdateQoSPolicyResponse = QoSPolicy
+
+	// VolumeGroupSnapshot is a single Volume Group Snapshot as returned by GetVolumeGroupSnapshot()
+	VolumeGroupSnapshot struct {
+		CreationTime                time.Time  `mapstructure:"creation_time"`
+		DataCreationTime            *time.Time `mapstructure:"data_creation_time"`
+		Description                 *string    `mapstructure:"description"`
+		GenerationNumber            *int       `mapstructure:"generation_number"`
+		ID                          int        `mapstructure:"id"`
+		IsApplicationConsistent     bool       `mapstructure:"is_application_consistent"`
+		IsAutoDeleteable            bool       `mapstructure:"is_auto_deleteable"`
+		IsDeleted                   bool       `mapstructure:"is_deleted"`
+		IsExistOnPeer               bool       `mapstructure:"is_exist_on_peer"`
+		IsExposable                 bool       `mapstructure:"is_exposable"`
+		IsExternal                  bool       `mapstructure:"is_external"`
+		IsOriginatingFromPeer       bool       `mapstructure:"is_originating_from_peer"`
+		LastExposedTime             *time.Time `mapstructure:"last_exposed_time"`
+		Name                        string     `mapstructure:"name"`
+		ReplicationSession          Ref        `mapstructure:"replication_session"`
+		RetentionPolicy             Ref        `mapstructure:"retention_policy"`
+		ShortName                   string     `mapstructure:"short_name"`
+		Source                      Ref        `mapstructure:"source"`
+		TriggeredBy                 string     `mapstructure:"triggered_by"`
+		VolsnapsProvisionedCapacity Capacity   `mapstructure:"volsnaps_provisioned_capacity"`
+		VolumeGroup                 Ref        `mapstructure:"volume_group"`
+		Wwn                         *string    `mapstructure:"wwn"`
 	}
 
 	// GetVolumeGroupSnapshotResponse Volume Group Snapshot GET response
 	GetVolumeGroupSnapshotResponse struct {
-		Hits []struct {
-			CreationTime                int         `mapstructure:"creation_time"`
-			DataCreationTime            interface{} `mapstructure:"data_creation_time"`
-			Description                 interface{} `mapstructure:"description"`
-			GenerationNumber            interface{} `mapstructure:"generation_number"`
-			ID                          int         `mapstructure:"id"`
-			IsApplicationConsistent     bool        `mapstructure:"is_application_consistent"`
-			IsAutoDeleteable            bool        `mapstructure:"is_auto_deleteable"`
-			IsDeleted                   bool        `mapstructure:"is_deleted"`
-			IsExistOnPeer               bool        `mapstructure:"is_exist_on_peer"`
-			IsExposable                 bool        `mapstructure:"is_exposable"`
-			IsExternal                  bool        `mapstructure:"is_external"`
-			IsOriginatingFromPeer       bool        `mapstructure:"is_originating_from_peer"`
-			LastExposedTime             int         `mapstructure:"last_exposed_time"`
-			Name                        string      `mapstructure:"name"`
-			ReplicationSession          interface{} `mapstructure:"replication_session"`
-			RetentionPolicy             string      `mapstructure:"retention_policy"`
-			ShortName                   string      `mapstructure:"short_name"`
-			Source                      string      `mapstructure:"source"`
-			TriggeredBy                 string      `mapstructure:"triggered_by"`
-			VolsnapsProvisionedCapacity int         `mapstructure:"volsnaps_provisioned_capacity"`
-			VolumeGroup                 string      `mapstructure:"volume_group"`
-			Wwn                         interface{} `mapstructure:"wwn"`
-		} `mapstructure:"hits"`
-		Limit  int `mapstructure:"limit"`
-		Offset int `mapstructure:"offset"`
-		Total  int `mapstructure:"total"`
+		Hits   []VolumeGroupSnapshot `mapstructure:"hits"`
+		Limit  int                   `mapstructure:"limit"`
+		Offset int                   `mapstructure:"offset"`
+		Total  int                   `mapstructure:"total"`
+	}
+
+	// CreateOrUpdateVolumeGroupSnapshotResponse holds the response of the CreateVolumeGroupSnapshot() function
+	CreateOrUpdateVolumeGroupSnapshotResponse = VolumeGroupSnapshot
+
+	// ReplicationPeerArray is a single Replication Peer Array as returned by GetReplicationPeerArrays(). A peer array
+	// is the remote Silk server that Replication Sessions replicate to.
+	ReplicationPeerArray struct {
+		ID       int    `mapstructure:"id"`
+		MgmtHost string `mapstructure:"mgmt_host"`
+		Name     string `mapstructure:"name"`
+		State    string `mapstructure:"state"`
+		Username string `mapstructure:"username"`
+	}
+
+	// GetReplicationPeerArraysResponse holds the response of the GetReplicationPeerArrays() function
+	GetReplicationPeerArraysResponse struct {
+		Hits   []ReplicationPeerArray `mapstructure:"hits"`
+		Limit  int                    `mapstructure:"limit"`
+		Offset int                    `mapstructure:"offset"`
+		Total  int                    `mapstructure:"total"`
+	}
+
+	// ReplicationSession is a single Replication Session as returned by GetReplicationSessions(). The RPO fields are
+	// decoded from the seconds returned by the Silk server.
+	ReplicationSession struct {
+		CurrentRole      ReplicationRole         `mapstructure:"current_role"`
+		CurrentRPO       time.Duration           `mapstructure:"current_rpo"` // Age of the last replicated snapshot
+		ID               int                     `mapstructure:"id"`
+		LastSyncTime     *time.Time              `mapstructure:"last_sync_time"`
+		LocalVolumeGroup Ref                     `mapstructure:"local_volume_group"`
+		Name             string                  `mapstructure:"name"`
+		PeerArray        Ref                     `mapstructure:"replication_peer_k2array"`
+		PeerVolumeGroup  Ref                     `mapstructure:"replication_peer_volume_group"`
+		RetentionPolicy  Ref                     `mapstructure:"retention_policy"`
+		RPO              time.Duration           `mapstructure:"rpo"` // Target RPO of the session
+		State            ReplicationSessionState `mapstructure:"state"`
+	}
+
+	// GetReplicationSessionsResponse holds the response of the GetReplicationSessions() function
+	GetReplicationSessionsResponse struct {
+		Hits   []ReplicationSession `mapstructure:"hits"`
+		Limit  int                  `mapstructure:"limit"`
+		Offset int                  `mapstructure:"offset"`
+		Total  int                  `mapstructure:"total"`
+	}
+
+	// System describes the Silk server as returned by GetSystem()
+	System struct {
+		ID       int            `mapstructure:"id"`
+		Model    string         `mapstructure:"model"`
+		Name     string         `mapstructure:"system_name"`
+		SerialNo string         `mapstructure:"system_id"`
+		State    string         `mapstructure:"state"`
+		SubState string         `mapstructure:"sub_state"`
+		Version  string         `mapstructure:"system_version"`
+		Capacity SystemCapacity `mapstructure:"-"` // Filled from /system/capacity
+	}
+
+	// SystemCapacity holds the capacity totals of the Silk server
+	SystemCapacity struct {
+		Allocated   Capacity `mapstructure:"allocated"`
+		Free        Capacity `mapstructure:"free"`
+		Logical     Capacity `mapstructure:"logical"`
+		Physical    Capacity `mapstructure:"physical"`
+		Provisioned Capacity `mapstructure:"provisioned"`
+		State       string   `mapstructure:"state"`
+		Total       Capacity `mapstructure:"total"`
 	}
 
-	// CreateOrUpdateVolumeGroupSnapshotResponse Volume Group Snapshotr POST/PATCH response
-	CreateOrUpdateVolumeGroupSnapshotResponse struct {
-		ShortName        string `mapstructure:"short_name"`
-		Source           string `mapstructure:"source"`
-		RetentionPolicy  string `mapstructure:"retention_policy"`
-		IsAutoDeleteable bool   `mapstructure:"is_auto_deleteable"`
-		IsExposable      bool   `mapstructure:"is_exposable"`
+	// Node is a single node (controller) of the Silk server as returned by GetSystemHealth()
+	Node struct {
+		ID    int    `mapstructure:"id"`
+		Name  string `mapstructure:"name"`
+		Role  string `mapstructure:"role"`
+		State string `mapstructure:"state"`
 	}
 
 	// DeleteResponse holds the response of the Delete base function. The status code will always be 204.
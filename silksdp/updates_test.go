@@ -0,0 +1,65 @@
+package silksdp
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func Test_ApplyVolumeUpdate(t *testing.T) {
+	var patch map[string]interface{}
+	silk := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/v2/volumes":
+			json.NewEncoder(w).Encode(map[string]interface{}{"hits": []interface{}{map[string]interface{}{"id": 7, "name": "vol01"}}, "total": 1})
+		case r.Method == "PATCH" && r.URL.Path == "/api/v2/volumes/7":
+			json.NewDecoder(r.Body).Decode(&patch)
+			json.NewEncoder(w).Encode(map[string]interface{}{"id": 7, "name": "vol01", "read_only": true})
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL)
+		}
+	})
+
+	_, err := silk.ApplyVolumeUpdate("vol01", VolumeUpdate{Description: String(""), ReadOnly: Bool(true)})
+	if err != nil {
+		t.Fatalf("Failed to update the volume: %v", err)
+	}
+	if len(patch) != 2 || patch["description"] != "" || patch["read_only"] != true {
+		t.Errorf("Expected only the set fields to be sent, got %v", patch)
+	}
+
+	if _, err := silk.ApplyVolumeUpdateCtx(context.Background(), "vol01", VolumeUpdate{}); err != errNoChanges {
+		t.Errorf("Expected errNoChanges for an empty update, got %v", err)
+	}
+}
+
+func Test_UpdateHostMap(t *testing.T) {
+	var patched bool
+	silk := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/v2/hosts":
+			json.NewEncoder(w).Encode(map[string]interface{}{"hits": []interface{}{map[string]interface{}{"id": 3, "name": "host01"}}, "total": 1})
+		case r.Method == "PATCH" && r.URL.Path == "/api/v2/hosts/3":
+			patched = true
+			json.NewEncoder(w).Encode(map[string]interface{}{"id": 3, "name": "host01"})
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL)
+		}
+	})
+
+	_, err := silk.UpdateHost("host01", map[string]interface{}{"name": "host02", "iqn": "iqn.2021-01.com.example"})
+	if err == nil || err.Error() != "The provided 'config' parameter contains invalid keys. 'name' and 'type' are the only valid choices" {
+		t.Errorf("Expected the invalid keys error, got %v", err)
+	}
+	if patched {
+		t.Errorf("Expected the invalid update not to send a PATCH request")
+	}
+
+	if _, err := silk.UpdateHostCtx(context.Background(), "host01", map[string]interface{}{}); err != nil {
+		t.Fatalf("Failed to send an empty update: %v", err)
+	}
+	if patched == false {
+		t.Errorf("Expected an empty config map to still send a PATCH request")
+	}
+}
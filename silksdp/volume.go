@@ -1,11 +1,8 @@
 package silksdp
 
 import (
+	"context"
 	"fmt"
-	"strconv"
-	"strings"
-
-	"github.com/mitchellh/mapstructure"
 )
 
 // CreateVolume creates a new Volume on the Silk server.
@@ -15,10 +12,16 @@ import (
 // `readOnly` corresponds to the "Exposure Type" radio button in the UI. When set to false, which is the default UI option, the volume will be set
 // set to "Read/Only"
 func (c *Credentials) CreateVolume(name string, sizeInGb int, volumeGroupName string, vmware bool, description string, readOnly bool, timeout ...int) (*CreateOrUpdateVolumeResponse, error) {
+	return c.CreateVolumeCtx(context.Background(), name, GiB(int64(sizeInGb)), volumeGroupName, vmware, description, readOnly, timeout...)
+}
+
+// CreateVolumeCtx is the context-aware form of CreateVolume. The size of the Volume is provided as a Capacity
+// (ex: silksdp.GiB(10)).
+func (c *Credentials) CreateVolumeCtx(ctx context.Context, name string, size Capacity, volumeGroupName string, vmware bool, description string, readOnly bool, timeout ...int) (*CreateOrUpdateVolumeResponse, error) {
 
 	httpTimeout := httpTimeout(timeout)
 
-	volumeGroupID, err := c.GetVolumeGroupID(volumeGroupName)
+	volumeGroupID, err := c.GetVolumeGroupIDCtx(ctx, volumeGroupName)
 	if err != nil {
 		return nil, err
 	}
@@ -28,20 +31,20 @@ func (c *Credentials) CreateVolume(name string, sizeInGb int, volumeGroupName st
 
 	config := map[string]interface{}{}
 	config["name"] = name
-	config["size"] = sizeInGb * 1024 * 1024
+	config["size"] = size
 	config["volume_group"] = volumeGroupConfig
 	config["vmware_support"] = vmware
 	config["description"] = description
 	config["read_only"] = readOnly
 
-	apiRequest, err := c.Post("/volumes", config, httpTimeout)
+	apiRequest, err := c.PostCtx(ctx, "/volumes", config, httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 
 	// Convert the API Response (map[string]interface{}) to a struct
 	var apiResponse CreateOrUpdateVolumeResponse
-	mapErr := mapstructure.Decode(apiRequest, &apiResponse)
+	mapErr := decode(apiRequest, &apiResponse)
 	if mapErr != nil {
 		return nil, mapErr
 	}
@@ -51,17 +54,46 @@ func (c *Credentials) CreateVolume(name string, sizeInGb int, volumeGroupName st
 
 // GetVolumes returns information on all Volumes found on the Silk server.
 func (c *Credentials) GetVolumes(timeout ...int) (*GetVolumesResponse, error) {
+	return c.GetVolumesCtx(context.Background(), timeout...)
+}
+
+// GetVolumesCtx is the context-aware form of GetVolumes.
+func (c *Credentials) GetVolumesCtx(ctx context.Context, timeout ...int) (*GetVolumesResponse, error) {
 
 	httpTimeout := httpTimeout(timeout)
 
-	apiRequest, err := c.Get("/volumes", httpTimeout)
+	apiRequest, err := c.getAllCtx(ctx, "/volumes", nil, httpTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert the API Response (map[string]interface{}) to a struct
+	var apiResponse GetVolumesResponse
+	mapErr := decode(apiRequest, &apiResponse)
+	if mapErr != nil {
+		return nil, mapErr
+	}
+
+	return &apiResponse, nil
+}
+
+// FindVolumes returns the Volumes matching the provided filter, fetching every page of the
+// results (ex: silksdp.Filter().Field("size").GreaterThan(silksdp.TiB(1)).Sort("-creation_time")).
+func (c *Credentials) FindVolumes(filter ListFilter, timeout ...int) (*GetVolumesResponse, error) {
+	return c.FindVolumesCtx(context.Background(), filter, timeout...)
+}
+
+// FindVolumesCtx is the context-aware form of FindVolumes.
+func (c *Credentials) FindVolumesCtx(ctx context.Context, filter ListFilter, timeout ...int) (*GetVolumesResponse, error) {
+
+	apiRequest, err := c.getAllCtx(ctx, "/volumes", filter, httpTimeout(timeout))
 	if err != nil {
 		return nil, err
 	}
 
 	// Convert the API Response (map[string]interface{}) to a struct
 	var apiResponse GetVolumesResponse
-	mapErr := mapstructure.Decode(apiRequest, &apiResponse)
+	mapErr := decode(apiRequest, &apiResponse)
 	if mapErr != nil {
 		return nil, mapErr
 	}
@@ -69,18 +101,24 @@ func (c *Credentials) GetVolumes(timeout ...int) (*GetVolumesResponse, error) {
 	return &apiResponse, nil
 }
 
+// GetVolumeName returns the Volume matching the provided Volume ID.
 func (c *Credentials) GetVolumeName(id int, timeout ...int) (*GetVolumesResponse, error) {
+	return c.GetVolumeNameCtx(context.Background(), id, timeout...)
+}
+
+// GetVolumeNameCtx is the context-aware form of GetVolumeName.
+func (c *Credentials) GetVolumeNameCtx(ctx context.Context, id int, timeout ...int) (*GetVolumesResponse, error) {
 
 	httpTimeout := httpTimeout(timeout)
 
-	apiRequest, err := c.Get(fmt.Sprintf("/volumes?id__in=%v", id), httpTimeout)
+	apiRequest, err := c.getAllCtx(ctx, "/volumes", Filter().ID().In(id), httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 
 	// Convert the API Response (map[string]interface{}) to a struct
 	var apiResponse GetVolumesResponse
-	mapErr := mapstructure.Decode(apiRequest, &apiResponse)
+	mapErr := decode(apiRequest, &apiResponse)
 	if mapErr != nil {
 		return nil, mapErr
 	}
@@ -88,11 +126,34 @@ func (c *Credentials) GetVolumeName(id int, timeout ...int) (*GetVolumesResponse
 	return &apiResponse, nil
 }
 
+// VolumeUpdate holds the changes applied to a Volume by ApplyVolumeUpdate(). Only the fields that are set are sent to
+// the Silk server.
+type VolumeUpdate struct {
+	// Name renames the Volume.
+	Name *string
+	// Size sets the provisioned size of the Volume.
+	Size *Capacity
+	// Description replaces the description of the Volume.
+	Description *string
+	// VolumeGroup moves the Volume to the Volume Group with the provided name.
+	VolumeGroup *string
+	// ReadOnly changes the exposure type of the Volume.
+	ReadOnly *bool
+}
+
 // UpdateVolume updates the configuration of a Volume on the Silk server.
 //
 // Valid keys for the config are: `name`, `size`, `description`, `volume_group`, and `read_only`.
+//
+// Deprecated: Use ApplyVolumeUpdate() and a VolumeUpdate, which are validated at compile time.
 func (c *Credentials) UpdateVolume(name string, config map[string]interface{}, timeout ...int) (*CreateOrUpdateVolumeResponse, error) {
+	return c.UpdateVolumeCtx(context.Background(), name, config, timeout...)
+}
 
+// UpdateVolumeCtx is the context-aware form of UpdateVolume.
+//
+// Deprecated: Use ApplyVolumeUpdateCtx() and a VolumeUpdate, which are validated at compile time.
+func (c *Credentials) UpdateVolumeCtx(ctx context.Context, name string, config map[string]interface{}, timeout ...int) (*CreateOrUpdateVolumeResponse, error) {
 	httpTimeout := httpTimeout(timeout)
 
 	// Validate that the user provided keys are valid for this API
@@ -109,19 +170,64 @@ func (c *Credentials) UpdateVolume(name string, config map[string]interface{}, t
 		return nil, fmt.Errorf("The provided 'config' parameter contains invalid keys. 'name', 'size', 'description', 'volume_group', and 'read_only' are the only valid choices")
 	}
 
-	volumeID, err := c.GetVolumeID(name)
+	return c.updateVolume(ctx, name, config, httpTimeout)
+}
+
+// ApplyVolumeUpdate applies the provided changes to a Volume. Only the fields of the update that are set are sent to
+// the Silk server, and an error is returned if none are.
+func (c *Credentials) ApplyVolumeUpdate(name string, update VolumeUpdate, timeout ...int) (*CreateOrUpdateVolumeResponse, error) {
+	return c.ApplyVolumeUpdateCtx(context.Background(), name, update, timeout...)
+}
+
+// ApplyVolumeUpdateCtx is the context-aware form of ApplyVolumeUpdate.
+func (c *Credentials) ApplyVolumeUpdateCtx(ctx context.Context, name string, update VolumeUpdate, timeout ...int) (*CreateOrUpdateVolumeResponse, error) {
+
+	httpTimeout := httpTimeout(timeout)
+
+	config := map[string]interface{}{}
+	if update.Name != nil {
+		config["name"] = *update.Name
+	}
+	if update.Size != nil {
+		config["size"] = *update.Size
+	}
+	if update.Description != nil {
+		config["description"] = *update.Description
+	}
+	if update.VolumeGroup != nil {
+		volumeGroupID, err := c.GetVolumeGroupIDCtx(ctx, *update.VolumeGroup, httpTimeout)
+		if err != nil {
+			return nil, err
+		}
+		config["volume_group"] = VolumeGroupRef(volumeGroupID)
+	}
+	if update.ReadOnly != nil {
+		config["read_only"] = *update.ReadOnly
+	}
+
+	if len(config) == 0 {
+		return nil, errNoChanges
+	}
+
+	return c.updateVolume(ctx, name, config, httpTimeout)
+}
+
+// updateVolume sends the PATCH request shared by UpdateVolumeCtx() and ApplyVolumeUpdateCtx().
+func (c *Credentials) updateVolume(ctx context.Context, name string, config map[string]interface{}, httpTimeout int) (*CreateOrUpdateVolumeResponse, error) {
+
+	volumeID, err := c.GetVolumeIDCtx(ctx, name, httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 
-	apiRequest, err := c.Patch(fmt.Sprintf("/volumes/%d", volumeID), config, httpTimeout)
+	apiRequest, err := c.PatchCtx(ctx, fmt.Sprintf("/volumes/%d", volumeID), config, httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 
 	// Convert the API Response (map[string]interface{}) to a struct
 	var apiResponse CreateOrUpdateVolumeResponse
-	mapErr := mapstructure.Decode(apiRequest, &apiResponse)
+	mapErr := decode(apiRequest, &apiResponse)
 	if mapErr != nil {
 		return nil, mapErr
 	}
@@ -131,37 +237,42 @@ func (c *Credentials) UpdateVolume(name string, config map[string]interface{}, t
 
 // DeleteVolume deletes a Volume from the Silk server.
 func (c *Credentials) DeleteVolume(name string, timeout ...int) (*DeleteResponse, error) {
+	return c.DeleteVolumeCtx(context.Background(), name, timeout...)
+}
+
+// DeleteVolumeCtx is the context-aware form of DeleteVolume.
+func (c *Credentials) DeleteVolumeCtx(ctx context.Context, name string, timeout ...int) (*DeleteResponse, error) {
 
 	httpTimeout := httpTimeout(timeout)
 
-	volumeID, err := c.GetVolumeID(name)
+	volumeID, err := c.GetVolumeIDCtx(ctx, name)
 	if err != nil {
 		return nil, err
 	}
 
 	// Remove Host mappings before remove volume
-	hostMappings, err := c.GetVolumeHostMappings(name, httpTimeout)
+	hostMappings, err := c.GetVolumeHostMappingsCtx(ctx, name, httpTimeout)
 	if len(hostMappings) > 0 {
 		for _, hostName := range hostMappings {
-			c.DeleteHostVolumeMapping(hostName, name, httpTimeout)
+			c.DeleteHostVolumeMappingCtx(ctx, hostName, name, httpTimeout)
 		}
 	}
 	// Remove Host group mappings before remove volume
-	hostGroupMappings, err := c.GetVolumeHostGroupMappings(name, httpTimeout)
+	hostGroupMappings, err := c.GetVolumeHostGroupMappingsCtx(ctx, name, httpTimeout)
 	if len(hostGroupMappings) > 0 {
 		for _, hostGroupName := range hostGroupMappings {
-			c.DeleteHostGroupVolumeMapping(hostGroupName, name, httpTimeout)
+			c.DeleteHostGroupVolumeMappingCtx(ctx, hostGroupName, name, httpTimeout)
 		}
 	}
 
-	apiRequest, err := c.Delete(fmt.Sprintf("/volumes/%d", volumeID), httpTimeout)
+	apiRequest, err := c.DeleteCtx(ctx, fmt.Sprintf("/volumes/%d", volumeID), httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 
 	// Convert the API Response (map[string]interface{}) to a struct
 	var apiResponse DeleteResponse
-	mapErr := mapstructure.Decode(apiRequest, &apiResponse)
+	mapErr := decode(apiRequest, &apiResponse)
 	if mapErr != nil {
 		return nil, mapErr
 	}
@@ -172,70 +283,45 @@ func (c *Credentials) DeleteVolume(name string, timeout ...int) (*DeleteResponse
 
 // GetVolumeID provides the ID for the provided host Volume name.
 func (c *Credentials) GetVolumeID(name string, timeout ...int) (int, error) {
+	return c.GetVolumeIDCtx(context.Background(), name, timeout...)
+}
 
-	httpTimeout := httpTimeout(timeout)
-
-	volumes, err := c.GetVolumes(httpTimeout)
-	if err != nil {
-		return 0, err
-	}
-
-	// Set volumeID to a value (-1) that can not be returned by the server
-	volumeID := -1
-	for _, volume := range volumes.Hits {
-		if volume.Name == name {
-			volumeID = volume.ID
-		}
-	}
+// GetVolumeIDCtx is the context-aware form of GetVolumeID.
+func (c *Credentials) GetVolumeIDCtx(ctx context.Context, name string, timeout ...int) (int, error) {
 
-	// If the volumeID has not been updated (i.e not found on the server) return an error message
-	if volumeID == -1 {
-		return 0, fmt.Errorf("The server does not contain a Volume named '%s'", name)
-	}
+	httpTimeout := httpTimeout(timeout)
 
-	return volumeID, nil
+	return c.lookupID(ctx, KindVolume, name, httpTimeout)
 }
 
 // GetVolumeHostMappings returns all Hosts that are mapped to the provided Volume.
 func (c *Credentials) GetVolumeHostMappings(volumeName string, timeout ...int) ([]string, error) {
+	return c.GetVolumeHostMappingsCtx(context.Background(), volumeName, timeout...)
+}
+
+// GetVolumeHostMappingsCtx is the context-aware form of GetVolumeHostMappings.
+func (c *Credentials) GetVolumeHostMappingsCtx(ctx context.Context, volumeName string, timeout ...int) ([]string, error) {
 
 	httpTimeout := httpTimeout(timeout)
 
-	volumeID, err := c.GetVolumeID(volumeName)
+	volumeID, err := c.GetVolumeIDCtx(ctx, volumeName)
 	if err != nil {
 		return nil, err
 	}
 
-	hostMappingsOnServer, err := c.GetHostMappings(httpTimeout)
+	hostMappingsOnServer, err := c.GetHostMappingsCtx(ctx, httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 
 	// Filter out the user provided volume and host from the hostMappingsOnServer
 	// results
-	hostName := []string{}
+	hostIDs := []int{}
 
 	for _, mapping := range hostMappingsOnServer {
-		if mapping.Volume.Ref == fmt.Sprintf("/volumes/%d", volumeID) {
-			var hostID int
-			if strings.Contains(mapping.Host.Ref, "/hosts") == true {
-
-				hostRefID := strings.Replace(mapping.Host.Ref, "/hosts/", "", 1)
-				hostID, err = strconv.Atoi(hostRefID)
-				if err != nil {
-					return nil, err
-				}
-
-				name, err := c.GetHostName(hostID)
-				if err != nil {
-					return nil, err
-				}
-
-				hostName = append(hostName, name)
-			}
-
+		if mapping.Volume == VolumeRef(volumeID) && mapping.Host.Kind() == KindHost {
+			hostIDs = append(hostIDs, mapping.Host.ID())
 		}
-
 	}
 
 	// If the mappingID has not been updated (i.e not found on the server) return an error message
@@ -243,49 +329,48 @@ func (c *Credentials) GetVolumeHostMappings(volumeName string, timeout ...int) (
 	// 	return nil, fmt.Errorf("No Host Mappings found on the Volume '%s'", volumeName)
 	// }
 
+	// Resolve every name in a single request rather than one lookup per mapping
+	names, err := c.resolveNames(ctx, KindHost, hostIDs, httpTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	hostName := []string{}
+	for _, id := range hostIDs {
+		hostName = append(hostName, names[id])
+	}
+
 	return hostName, nil
 }
 
 // GetVolumeHostGroupMappings returns all Host Groups that are mapped to the provided Volume.
 func (c *Credentials) GetVolumeHostGroupMappings(volumeName string, timeout ...int) ([]string, error) {
+	return c.GetVolumeHostGroupMappingsCtx(context.Background(), volumeName, timeout...)
+}
+
+// GetVolumeHostGroupMappingsCtx is the context-aware form of GetVolumeHostGroupMappings.
+func (c *Credentials) GetVolumeHostGroupMappingsCtx(ctx context.Context, volumeName string, timeout ...int) ([]string, error) {
 
 	httpTimeout := httpTimeout(timeout)
 
-	volumeID, err := c.GetVolumeID(volumeName)
+	volumeID, err := c.GetVolumeIDCtx(ctx, volumeName)
 	if err != nil {
 		return nil, err
 	}
 
-	hostGroupMappingsOnServer, err := c.GetHostMappings(httpTimeout)
+	hostGroupMappingsOnServer, err := c.GetHostMappingsCtx(ctx, httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 
 	// Filter out the user provided volume and host from the hostMappingsOnServer
 	// results
-	hostName := []string{}
+	hostGroupIDs := []int{}
 
 	for _, mapping := range hostGroupMappingsOnServer {
-		if mapping.Volume.Ref == fmt.Sprintf("/volumes/%d", volumeID) {
-			var hostGroupID int
-			if strings.Contains(mapping.Host.Ref, "/host_groups") == true {
-
-				hostGroupRefID := strings.Replace(mapping.Host.Ref, "/host_groups/", "", 1)
-				hostGroupID, err = strconv.Atoi(hostGroupRefID)
-				if err != nil {
-					return nil, err
-				}
-
-				name, err := c.GetHostGroupName(hostGroupID)
-				if err != nil {
-					return nil, err
-				}
-
-				hostName = append(hostName, name)
-			}
-
+		if mapping.Volume == VolumeRef(volumeID) && mapping.Host.Kind() == KindHostGroup {
+			hostGroupIDs = append(hostGroupIDs, mapping.Host.ID())
 		}
-
 	}
 
 	// If the mappingID has not been updated (i.e not found on the server) return an error message
@@ -293,49 +378,48 @@ func (c *Credentials) GetVolumeHostGroupMappings(volumeName string, timeout ...i
 	// 	return nil, fmt.Errorf("No Host Mappings found on the Volume '%s'", volumeName)
 	// }
 
+	// Resolve every name in a single request rather than one lookup per mapping
+	names, err := c.resolveNames(ctx, KindHostGroup, hostGroupIDs, httpTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	hostName := []string{}
+	for _, id := range hostGroupIDs {
+		hostName = append(hostName, names[id])
+	}
+
 	return hostName, nil
 }
 
 // GetVolumeGroupHostGroupMappings returns all Host Groups that are mapped to the provided Volume Group.
 func (c *Credentials) GetVolumeGroupHostGroupMappings(volumeGroupName string, timeout ...int) ([]string, error) {
+	return c.GetVolumeGroupHostGroupMappingsCtx(context.Background(), volumeGroupName, timeout...)
+}
+
+// GetVolumeGroupHostGroupMappingsCtx is the context-aware form of GetVolumeGroupHostGroupMappings.
+func (c *Credentials) GetVolumeGroupHostGroupMappingsCtx(ctx context.Context, volumeGroupName string, timeout ...int) ([]string, error) {
 
 	httpTimeout := httpTimeout(timeout)
 
-	volumeGroupID, err := c.GetVolumeGroupID(volumeGroupName)
+	volumeGroupID, err := c.GetVolumeGroupIDCtx(ctx, volumeGroupName)
 	if err != nil {
 		return nil, err
 	}
 
-	hostGroupMappingsOnServer, err := c.GetHostMappings(httpTimeout)
+	hostGroupMappingsOnServer, err := c.GetHostMappingsCtx(ctx, httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 
 	// Filter out the user provided volume and host from the hostMappingsOnServer
 	// results
-	hostName := []string{}
+	hostGroupIDs := []int{}
 
 	for _, mapping := range hostGroupMappingsOnServer {
-		if mapping.Volume.Ref == fmt.Sprintf("/volume_groups/%d", volumeGroupID) {
-			var hostGroupID int
-			if strings.Contains(mapping.Host.Ref, "/host_groups") == true {
-
-				hostGroupRefID := strings.Replace(mapping.Host.Ref, "/host_groups/", "", 1)
-				hostGroupID, err = strconv.Atoi(hostGroupRefID)
-				if err != nil {
-					return nil, err
-				}
-
-				name, err := c.GetHostGroupName(hostGroupID)
-				if err != nil {
-					return nil, err
-				}
-
-				hostName = append(hostName, name)
-			}
-
+		if mapping.Volume == VolumeGroupRef(volumeGroupID) && mapping.Host.Kind() == KindHostGroup {
+			hostGroupIDs = append(hostGroupIDs, mapping.Host.ID())
 		}
-
 	}
 
 	// If the mappingID has not been updated (i.e not found on the server) return an error message
@@ -343,28 +427,41 @@ func (c *Credentials) GetVolumeGroupHostGroupMappings(volumeGroupName string, ti
 	// 	return nil, fmt.Errorf("No Host Mappings found on the Volume Group '%s'", volumeGroupName)
 	// }
 
+	// Resolve every name in a single request rather than one lookup per mapping
+	names, err := c.resolveNames(ctx, KindHostGroup, hostGroupIDs, httpTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	hostName := []string{}
+	for _, id := range hostGroupIDs {
+		hostName = append(hostName, names[id])
+	}
+
 	return hostName, nil
 }
 
 // GetVolumeByName submits a strict API query for host objects of a specific name.
 func (c *Credentials) GetVolumeByName(volumename string, timeout ...int) (*GetVolumesResponse, error) {
+	return c.GetVolumeByNameCtx(context.Background(), volumename, timeout...)
+}
 
-	httpTimeout := httpTimeout(timeout)
+// GetVolumeByNameCtx is the context-aware form of GetVolumeByName.
+func (c *Credentials) GetVolumeByNameCtx(ctx context.Context, volumename string, timeout ...int) (*GetVolumesResponse, error) {
 
-	enduri := ("/volumes?name__contains=" + volumename)
+	httpTimeout := httpTimeout(timeout)
 
-	apiRequest, err := c.Get(enduri, httpTimeout)
+	apiRequest, err := c.getAllCtx(ctx, "/volumes", Filter().Name().Contains(volumename), httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 
 	// Convert the API Response (map[string]interface{}) to a struct
 	var apiResponse GetVolumesResponse
-	mapErr := mapstructure.Decode(apiRequest, &apiResponse)
+	mapErr := decode(apiRequest, &apiResponse)
 	if mapErr != nil {
 		return nil, mapErr
 	}
 
 	return &apiResponse, nil
 }
-
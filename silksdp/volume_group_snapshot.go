@@ -1,22 +1,28 @@
 package silksdp
 
 import (
+	"context"
 	"fmt"
-
-	"github.com/mitchellh/mapstructure"
+	"sort"
+	"time"
 )
 
 // GetVolumeGroupSnapshot returns information on all Volume Group Snapshots found on the Silk server.
-func (c *Credentials) GetVolumeGroupSnapshot(timeout ...int) (*GetVolumeGroupSnapshotResponse, error) { // <- here
+func (c *Credentials) GetVolumeGroupSnapshot(timeout ...int) (*GetVolumeGroupSnapshotResponse, error) {
+	return c.GetVolumeGroupSnapshotCtx(context.Background(), timeout...)
+}
+
+// GetVolumeGroupSnapshotCtx is the context-aware form of GetVolumeGroupSnapshot.
+func (c *Credentials) GetVolumeGroupSnapshotCtx(ctx context.Context, timeout ...int) (*GetVolumeGroupSnapshotResponse, error) {
 	httpTimeout := httpTimeout(timeout)
 
-	apiRequest, err := c.Get("/snapshots", httpTimeout) // <- here
+	apiRequest, err := c.getAllCtx(ctx, "/snapshots", nil, httpTimeout) // <- here
 	if err != nil {
 		return nil, err
 	}
 	// Convert the API Response (map[string]interface{}) to a struct
 	var apiResponse GetVolumeGroupSnapshotResponse // <- here
-	mapErr := mapstructure.Decode(apiRequest, &apiResponse)
+	mapErr := decode(apiRequest, &apiResponse)
 	if mapErr != nil {
 		return nil, mapErr
 	}
@@ -24,69 +30,236 @@ func (c *Credentials) GetVolumeGroupSnapshot(timeout ...int) (*GetVolumeGroupSna
 	return &apiResponse, nil
 }
 
-// GetVolumeGroupSnapshotID helper function to get snapshot by ID
-func (c *Credentials) GetVolumeGroupSnapshotID(name string, timeout ...int) (int, error) { // <- here
+// FindVolumeGroupSnapshots returns the Volume Group Snapshots matching the provided filter, fetching every page of the
+// results (ex: silksdp.Filter().Sort("-creation_time").Limit(10)).
+func (c *Credentials) FindVolumeGroupSnapshots(filter ListFilter, timeout ...int) (*GetVolumeGroupSnapshotResponse, error) {
+	return c.FindVolumeGroupSnapshotsCtx(context.Background(), filter, timeout...)
+}
 
-	httpTimeout := httpTimeout(timeout)
+// FindVolumeGroupSnapshotsCtx is the context-aware form of FindVolumeGroupSnapshots.
+func (c *Credentials) FindVolumeGroupSnapshotsCtx(ctx context.Context, filter ListFilter, timeout ...int) (*GetVolumeGroupSnapshotResponse, error) {
 
-	objectsOnServer, err := c.GetVolumeGroupSnapshot(httpTimeout) // <- here
+	apiRequest, err := c.getAllCtx(ctx, "/snapshots", filter, httpTimeout(timeout))
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
-	// Set objectID to a value (-1) that can not be returned by the server
-	objectID := -1
-	for _, object := range objectsOnServer.Hits {
-		if object.Name == name {
-			objectID = object.ID
+	// Convert the API Response (map[string]interface{}) to a struct
+	var apiResponse GetVolumeGroupSnapshotResponse
+	mapErr := decode(apiRequest, &apiResponse)
+	if mapErr != nil {
+		return nil, mapErr
+	}
+
+	return &apiResponse, nil
+}
+
+// SnapshotFilter selects the snapshots returned by ListSnapshots(). Fields left to their zero value do not filter.
+type SnapshotFilter struct {
+	// VolumeGroup is the name of the Volume Group the snapshots belong to.
+	VolumeGroup string
+	// CreatedAfter only keeps the snapshots created after this time.
+	CreatedAfter time.Time
+	// CreatedBefore only keeps the snapshots created before this time.
+	CreatedBefore time.Time
+	// RetentionPolicy is the name of the Retention Policy of the snapshots.
+	RetentionPolicy string
+	// TriggeredBy only keeps the snapshots created by this trigger (ex: user, scheduler).
+	TriggeredBy string
+	// Deletable only keeps the snapshots whose IsAutoDeleteable field matches.
+	Deletable *bool
+}
+
+// ListSnapshots returns the Volume Group Snapshots matching the provided filter, oldest first. Views, which are
+// returned by GetSnapshotViews(), are not included.
+func (c *Credentials) ListSnapshots(filter SnapshotFilter, timeout ...int) ([]VolumeGroupSnapshot, error) {
+	return c.ListSnapshotsCtx(context.Background(), filter, timeout...)
+}
+
+// ListSnapshotsCtx is the context-aware form of ListSnapshots.
+func (c *Credentials) ListSnapshotsCtx(ctx context.Context, filter SnapshotFilter, timeout ...int) ([]VolumeGroupSnapshot, error) {
+
+	httpTimeout := httpTimeout(timeout)
+
+	var volumeGroup, retentionPolicy Ref
+	if filter.VolumeGroup != "" {
+		volumeGroupID, err := c.GetVolumeGroupIDCtx(ctx, filter.VolumeGroup, httpTimeout)
+		if err != nil {
+			return nil, err
 		}
+		volumeGroup = VolumeGroupRef(volumeGroupID)
+	}
+	if filter.RetentionPolicy != "" {
+		retentionPolicyID, err := c.GetRetentionPolicyIDCtx(ctx, filter.RetentionPolicy, httpTimeout)
+		if err != nil {
+			return nil, err
+		}
+		retentionPolicy = RetentionPolicyRef(retentionPolicyID)
+	}
+
+	// Narrow the request with the filters the Silk server understands, the others are applied below
+	query := Filter().Sort("creation_time")
+	if filter.CreatedAfter.IsZero() == false {
+		query.Field("creation_time").GreaterThan(filter.CreatedAfter.Unix())
+	}
+	if filter.CreatedBefore.IsZero() == false {
+		query.Field("creation_time").LessThan(filter.CreatedBefore.Unix())
+	}
+	if filter.TriggeredBy != "" {
+		query.Field("triggered_by").Equals(filter.TriggeredBy)
+	}
+
+	apiRequest, err := c.getAllCtx(ctx, "/snapshots", query, httpTimeout)
+	if err != nil {
+		return nil, err
+	}
 
+	// Convert the API Response (map[string]interface{}) to a struct
+	var apiResponse GetVolumeGroupSnapshotResponse
+	mapErr := decode(apiRequest, &apiResponse)
+	if mapErr != nil {
+		return nil, mapErr
 	}
 
-	// If the objectID has not been updated (i.e not found on the server) return an error message
-	if objectID == -1 {
-		return 0, fmt.Errorf("The server does not contain a Volume Group Snapshot named '%s'", name) // <- here
+	snapshots := []VolumeGroupSnapshot{}
+	for _, snapshot := range apiResponse.Hits {
+		switch {
+		case isSnapshotView(snapshot):
+		case volumeGroup.IsZero() == false && snapshot.VolumeGroup != volumeGroup:
+		case retentionPolicy.IsZero() == false && snapshot.RetentionPolicy != retentionPolicy:
+		case filter.CreatedAfter.IsZero() == false && snapshot.CreationTime.After(filter.CreatedAfter) == false:
+		case filter.CreatedBefore.IsZero() == false && snapshot.CreationTime.Before(filter.CreatedBefore) == false:
+		case filter.TriggeredBy != "" && snapshot.TriggeredBy != filter.TriggeredBy:
+		case filter.Deletable != nil && snapshot.IsAutoDeleteable != *filter.Deletable:
+		default:
+			snapshots = append(snapshots, snapshot)
+		}
 	}
 
-	return objectID, nil
+	sort.SliceStable(snapshots, func(i, j int) bool {
+		return snapshots[i].CreationTime.Before(snapshots[j].CreationTime)
+	})
 
+	return snapshots, nil
 }
 
-// CreateVolumeGroupSnapshot creates a new Volume Group Snapshot on the Silk server.
-func (c *Credentials) CreateVolumeGroupSnapshot(name string, volumegroupname string, retentionpolicyname string, deletable bool, exposable bool, timeout ...int) (*CreateOrUpdateVolumeGroupSnapshotResponse, error) { // <- here
+// LatestSnapshot returns the most recent snapshot of the provided Volume Group.
+func (c *Credentials) LatestSnapshot(volumeGroupName string, timeout ...int) (*VolumeGroupSnapshot, error) {
+	return c.LatestSnapshotCtx(context.Background(), volumeGroupName, timeout...)
+}
 
-	httpTimeout := httpTimeout(timeout)
+// LatestSnapshotCtx is the context-aware form of LatestSnapshot.
+func (c *Credentials) LatestSnapshotCtx(ctx context.Context, volumeGroupName string, timeout ...int) (*VolumeGroupSnapshot, error) {
 
-	// Get volume id from name and construct ref path
-	volumeGroupID, err := c.GetVolumeGroupID(volumegroupname, httpTimeout)
+	snapshots, err := c.ListSnapshotsCtx(ctx, SnapshotFilter{VolumeGroup: volumeGroupName}, timeout...)
 	if err != nil {
 		return nil, err
 	}
 
-	volumegrouppath := fmt.Sprintf("@{ref=/volume_groups/%d", volumeGroupID)
+	if len(snapshots) == 0 {
+		return nil, fmt.Errorf("The Volume Group '%s' does not have any snapshots", volumeGroupName)
+	}
+
+	return &snapshots[len(snapshots)-1], nil
+}
+
+// GetVolumeGroupSnapshotID helper function to get snapshot by ID
+func (c *Credentials) GetVolumeGroupSnapshotID(name string, timeout ...int) (int, error) {
+	return c.GetVolumeGroupSnapshotIDCtx(context.Background(), name, timeout...)
+}
+
+// GetVolumeGroupSnapshotIDCtx is the context-aware form of GetVolumeGroupSnapshotID.
+func (c *Credentials) GetVolumeGroupSnapshotIDCtx(ctx context.Context, name string, timeout ...int) (int, error) {
+
+	httpTimeout := httpTimeout(timeout)
+
+	return c.lookupID(ctx, KindVolumeGroupSnapshot, name, httpTimeout)
+}
+
+// CreateVolumeGroupSnapshot creates a new Volume Group Snapshot on the Silk server.
+func (c *Credentials) CreateVolumeGroupSnapshot(name string, volumegroupname string, retentionpolicyname string, deletable bool, exposable bool, timeout ...int) (*CreateOrUpdateVolumeGroupSnapshotResponse, error) {
+	return c.CreateVolumeGroupSnapshotCtx(context.Background(), name, volumegroupname, retentionpolicyname, deletable, exposable, timeout...)
+}
+
+// CreateVolumeGroupSnapshotCtx is the context-aware form of CreateVolumeGroupSnapshot.
+func (c *Credentials) CreateVolumeGroupSnapshotCtx(ctx context.Context, name string, volumegroupname string, retentionpolicyname string, deletable bool, exposable bool, timeout ...int) (*CreateOrUpdateVolumeGroupSnapshotResponse, error) {
+
+	options := SnapshotOptions{
+		Name:            name,
+		RetentionPolicy: retentionpolicyname,
+		Deletable:       deletable,
+		Exposable:       exposable,
+	}
+
+	return c.createSnapshot(ctx, volumegroupname, options, httpTimeout(timeout))
+}
+
+// SnapshotOptions holds the settings of a snapshot created by CreateSnapshot().
+type SnapshotOptions struct {
+	// Name is the short name of the snapshot. The Silk server prefixes it with the name of the Volume Group
+	// (ex: vg01:daily).
+	Name string
+	// RetentionPolicy is the name of the Retention Policy of the snapshot.
+	RetentionPolicy string
+	// Deletable allows the snapshot to be deleted automatically by its Retention Policy.
+	Deletable bool
+	// Exposable allows views of the snapshot to be created and mapped to Hosts.
+	Exposable bool
+	// Description is an optional description of the snapshot.
+	Description string
+	// ApplicationConsistent marks the snapshot as taken while the applications using the Volume Group were quiesced.
+	ApplicationConsistent bool
+}
+
+// CreateSnapshot creates a snapshot of the provided Volume Group and returns it as stored on the Silk server.
+func (c *Credentials) CreateSnapshot(volumeGroupName string, opts SnapshotOptions, timeout ...int) (*VolumeGroupSnapshot, error) {
+	return c.CreateSnapshotCtx(context.Background(), volumeGroupName, opts, timeout...)
+}
+
+// CreateSnapshotCtx is the context-aware form of CreateSnapshot.
+func (c *Credentials) CreateSnapshotCtx(ctx context.Context, volumeGroupName string, opts SnapshotOptions, timeout ...int) (*VolumeGroupSnapshot, error) {
+	return c.createSnapshot(ctx, volumeGroupName, opts, httpTimeout(timeout))
+}
 
-	// Get rep retention policy id from name and construct ref path
-	retentionpolicyID, err := c.GetRetentionPolicyID(retentionpolicyname, httpTimeout)
+// createSnapshot sends the POST request shared by CreateVolumeGroupSnapshot() and CreateSnapshot().
+func (c *Credentials) createSnapshot(ctx context.Context, volumeGroupName string, opts SnapshotOptions, httpTimeout int) (*VolumeGroupSnapshot, error) {
+
+	if opts.Name == "" {
+		return nil, fmt.Errorf("A name is required to create a snapshot")
+	}
+	if opts.RetentionPolicy == "" {
+		return nil, fmt.Errorf("A Retention Policy is required to create a snapshot")
+	}
+
+	volumeGroupID, err := c.GetVolumeGroupIDCtx(ctx, volumeGroupName, httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 
-	retentionpolicypath := fmt.Sprintf("@{ref=/retention_policies/%d", retentionpolicyID)
+	retentionPolicyID, err := c.GetRetentionPolicyIDCtx(ctx, opts.RetentionPolicy, httpTimeout)
+	if err != nil {
+		return nil, err
+	}
 
 	config := map[string]interface{}{}
-	config["name"] = name
-	config["volume_group"] = volumegrouppath
-	config["retention_policy"] = retentionpolicypath
-	config["deletable"] = deletable
-	config["exposable"] = exposable
+	config["short_name"] = opts.Name
+	config["volume_group"] = VolumeGroupRef(volumeGroupID)
+	config["retention_policy"] = RetentionPolicyRef(retentionPolicyID)
+	config["is_auto_deleteable"] = opts.Deletable
+	config["is_exposable"] = opts.Exposable
+	config["is_application_consistent"] = opts.ApplicationConsistent
+	if opts.Description != "" {
+		config["description"] = opts.Description
+	}
 
-	apiRequest, err := c.Post("/snapshots", config, httpTimeout) // <- here
+	apiRequest, err := c.PostCtx(ctx, "/snapshots", config, httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 
-	var apiResponse CreateOrUpdateVolumeGroupSnapshotResponse // <- here
-	mapErr := mapstructure.Decode(apiRequest, &apiResponse)
+	// Convert the API Response (map[string]interface{}) to a struct
+	var apiResponse VolumeGroupSnapshot
+	mapErr := decode(apiRequest, &apiResponse)
 	if mapErr != nil {
 		return nil, mapErr
 	}
@@ -140,23 +313,28 @@ func (c *Credentials) UpdateVolumeGroupSnapshot(name string, config map[string]i
 */
 
 // DeleteVolumeGroupSnapshot deletes a Volume Group Snapshot from the Silk server.
-func (c *Credentials) DeleteVolumeGroupSnapshot(name string, timeout ...int) (*DeleteResponse, error) { // <- here
+func (c *Credentials) DeleteVolumeGroupSnapshot(name string, timeout ...int) (*DeleteResponse, error) {
+	return c.DeleteVolumeGroupSnapshotCtx(context.Background(), name, timeout...)
+}
+
+// DeleteVolumeGroupSnapshotCtx is the context-aware form of DeleteVolumeGroupSnapshot.
+func (c *Credentials) DeleteVolumeGroupSnapshotCtx(ctx context.Context, name string, timeout ...int) (*DeleteResponse, error) {
 
 	httpTimeout := httpTimeout(timeout)
 
-	VolumeGroupSnapshotID, err := c.GetVolumeGroupSnapshotID(name, httpTimeout) // <- here
+	VolumeGroupSnapshotID, err := c.GetVolumeGroupSnapshotIDCtx(ctx, name, httpTimeout) // <- here
 	if err != nil {
 		return nil, err
 	}
 
-	apiRequest, err := c.Delete(fmt.Sprintf("/snapshots/%d", VolumeGroupSnapshotID), httpTimeout) // <- here
+	apiRequest, err := c.DeleteCtx(ctx, fmt.Sprintf("/snapshots/%d", VolumeGroupSnapshotID), httpTimeout) // <- here
 	if err != nil {
 		return nil, err
 	}
 
 	// Convert the API Response (map[string]interface{}) to a struct
 	var apiResponse DeleteResponse
-	mapErr := mapstructure.Decode(apiRequest, &apiResponse)
+	mapErr := decode(apiRequest, &apiResponse)
 	if mapErr != nil {
 		return nil, mapErr
 	}
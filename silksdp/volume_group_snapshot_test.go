@@ -0,0 +1,89 @@
+package silksdp
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func Test_CreateSnapshot(t *testing.T) {
+	var created map[string]interface{}
+	silk := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		hits := []interface{}{}
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/api/v2/snapshots":
+			json.NewDecoder(r.Body).Decode(&created)
+			response := map[string]interface{}{"id": 8, "name": "vg01:daily", "creation_time": 1600000000}
+			for key, value := range created {
+				response[key] = value
+			}
+			json.NewEncoder(w).Encode(response)
+			return
+		case r.URL.Path == "/api/v2/volume_groups":
+			hits = append(hits, map[string]interface{}{"id": 3, "name": "vg01"})
+		case r.URL.Path == "/api/v2/retention_policies":
+			hits = append(hits, map[string]interface{}{"id": 1, "name": "Backup"})
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL)
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"hits": hits, "total": len(hits)})
+	})
+
+	snapshot, err := silk.CreateSnapshot("vg01", SnapshotOptions{Name: "daily", RetentionPolicy: "Backup", Description: "Nightly", ApplicationConsistent: true})
+	if err != nil {
+		t.Fatalf("Failed to create the snapshot: %v", err)
+	}
+
+	volumeGroup, _ := created["volume_group"].(map[string]interface{})
+	retentionPolicy, _ := created["retention_policy"].(map[string]interface{})
+	if volumeGroup["ref"] != "/volume_groups/3" || retentionPolicy["ref"] != "/retention_policies/1" {
+		t.Errorf("Expected the references to be sent as objects, got %v", created)
+	}
+	if created["short_name"] != "daily" || created["description"] != "Nightly" || created["is_application_consistent"] != true {
+		t.Errorf("Unexpected snapshot request: %v", created)
+	}
+
+	if snapshot.ID != 8 || snapshot.VolumeGroup != VolumeGroupRef(3) || snapshot.RetentionPolicy != RetentionPolicyRef(1) || snapshot.CreationTime.Equal(time.Unix(1600000000, 0)) == false {
+		t.Errorf("Unexpected snapshot: %+v", snapshot)
+	}
+}
+
+func Test_ListSnapshots(t *testing.T) {
+	var query string
+	silk := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		hits := []interface{}{}
+		switch r.URL.Path {
+		case "/api/v2/snapshots":
+			query = r.URL.RawQuery
+			hits = append(hits,
+				map[string]interface{}{"id": 12, "name": "vg01:b", "creation_time": 1600000200, "volume_group": map[string]interface{}{"ref": "/volume_groups/3"}, "is_auto_deleteable": true},
+				map[string]interface{}{"id": 11, "name": "vg01:a", "creation_time": 1600000100, "volume_group": map[string]interface{}{"ref": "/volume_groups/3"}, "is_auto_deleteable": true},
+				map[string]interface{}{"id": 13, "name": "vg01:kept", "creation_time": 1600000300, "volume_group": map[string]interface{}{"ref": "/volume_groups/3"}},
+				map[string]interface{}{"id": 14, "name": "vg02:a", "creation_time": 1600000400, "volume_group": map[string]interface{}{"ref": "/volume_groups/4"}, "is_auto_deleteable": true},
+				map[string]interface{}{"id": 15, "name": "vg01:view", "creation_time": 1600000500, "volume_group": map[string]interface{}{"ref": "/volume_groups/3"}, "source": map[string]interface{}{"ref": "/snapshots/11"}},
+			)
+		case "/api/v2/volume_groups":
+			hits = append(hits, map[string]interface{}{"id": 3, "name": "vg01"})
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL)
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"hits": hits, "total": len(hits)})
+	})
+
+	snapshots, err := silk.ListSnapshots(SnapshotFilter{VolumeGroup: "vg01", CreatedAfter: time.Unix(1600000000, 0), Deletable: Bool(true)})
+	if err != nil {
+		t.Fatalf("Failed to list the snapshots: %v", err)
+	}
+	if len(snapshots) != 2 || snapshots[0].ID != 11 || snapshots[1].ID != 12 {
+		t.Errorf("Expected the deletable snapshots of vg01 oldest first, got %+v", snapshots)
+	}
+	if query != "creation_time__gt=1600000000&__sort=creation_time&__limit=500" {
+		t.Errorf("Unexpected query: %s", query)
+	}
+
+	latest, err := silk.LatestSnapshot("vg01")
+	if err != nil || latest.ID != 13 {
+		t.Errorf("Expected the latest snapshot to skip the view, got %+v (%v)", latest, err)
+	}
+}
@@ -1,34 +1,37 @@
 package silksdp
 
 import (
+	"context"
 	"fmt"
-	"strconv"
-	"strings"
-
-	"github.com/mitchellh/mapstructure"
 )
 
 // CreateVolumeGroup creates a new Volume Group on the Silk server.
 //
 // `enableDeDuplication` corresponds to "Provisioning Type" in the UI. When set to true, the Provisioning Type will be "thin provisioning with dedupe"
 func (c *Credentials) CreateVolumeGroup(name string, quotaInGb int, enableDeDuplication bool, description string, capacityPolicy string, timeout ...int) (*CreateOrUpdateVolumeGroupResponse, error) {
+	return c.CreateVolumeGroupCtx(context.Background(), name, GiB(int64(quotaInGb)), enableDeDuplication, description, capacityPolicy, timeout...)
+}
+
+// CreateVolumeGroupCtx is the context-aware form of CreateVolumeGroup. The quota of the Volume Group is provided as a
+// Capacity (ex: silksdp.TiB(2)).
+func (c *Credentials) CreateVolumeGroupCtx(ctx context.Context, name string, quota Capacity, enableDeDuplication bool, description string, capacityPolicy string, timeout ...int) (*CreateOrUpdateVolumeGroupResponse, error) {
 
 	httpTimeout := httpTimeout(timeout)
 
 	config := map[string]interface{}{}
 	config["name"] = name
-	config["quota"] = quotaInGb * 1024 * 1024
+	config["quota"] = quota
 	config["is_dedup"] = enableDeDuplication
 	config["description"] = description
 	config["capacityPolicy"] = capacityPolicy
 
-	apiRequest, err := c.Post("/volume_groups", config, httpTimeout)
+	apiRequest, err := c.PostCtx(ctx, "/volume_groups", config, httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 
 	var apiResponse CreateOrUpdateVolumeGroupResponse
-	mapErr := mapstructure.Decode(apiRequest, &apiResponse)
+	mapErr := decode(apiRequest, &apiResponse)
 	if mapErr != nil {
 		return nil, mapErr
 	}
@@ -38,17 +41,46 @@ func (c *Credentials) CreateVolumeGroup(name string, quotaInGb int, enableDeDupl
 
 // GetVolumeGroups returns information on all Volume Groups found on the Silk server.
 func (c *Credentials) GetVolumeGroups(timeout ...int) (*GetVolumeGroupsResponse, error) {
+	return c.GetVolumeGroupsCtx(context.Background(), timeout...)
+}
+
+// GetVolumeGroupsCtx is the context-aware form of GetVolumeGroups.
+func (c *Credentials) GetVolumeGroupsCtx(ctx context.Context, timeout ...int) (*GetVolumeGroupsResponse, error) {
 
 	httpTimeout := httpTimeout(timeout)
 
-	apiRequest, err := c.Get("/volume_groups", httpTimeout)
+	apiRequest, err := c.getAllCtx(ctx, "/volume_groups", nil, httpTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert the API Response (map[string]interface{}) to a struct
+	var apiResponse GetVolumeGroupsResponse
+	mapErr := decode(apiRequest, &apiResponse)
+	if mapErr != nil {
+		return nil, mapErr
+	}
+
+	return &apiResponse, nil
+}
+
+// FindVolumeGroups returns the Volume Groups matching the provided filter, fetching every page of the
+// results (ex: silksdp.Filter().Name().Contains("prod")).
+func (c *Credentials) FindVolumeGroups(filter ListFilter, timeout ...int) (*GetVolumeGroupsResponse, error) {
+	return c.FindVolumeGroupsCtx(context.Background(), filter, timeout...)
+}
+
+// FindVolumeGroupsCtx is the context-aware form of FindVolumeGroups.
+func (c *Credentials) FindVolumeGroupsCtx(ctx context.Context, filter ListFilter, timeout ...int) (*GetVolumeGroupsResponse, error) {
+
+	apiRequest, err := c.getAllCtx(ctx, "/volume_groups", filter, httpTimeout(timeout))
 	if err != nil {
 		return nil, err
 	}
 
 	// Convert the API Response (map[string]interface{}) to a struct
 	var apiResponse GetVolumeGroupsResponse
-	mapErr := mapstructure.Decode(apiRequest, &apiResponse)
+	mapErr := decode(apiRequest, &apiResponse)
 	if mapErr != nil {
 		return nil, mapErr
 	}
@@ -56,14 +88,36 @@ func (c *Credentials) GetVolumeGroups(timeout ...int) (*GetVolumeGroupsResponse,
 	return &apiResponse, nil
 }
 
+// VolumeGroupUpdate holds the changes applied to a Volume Group by ApplyVolumeGroupUpdate(). Only the fields that are
+// set are sent to the Silk server.
+type VolumeGroupUpdate struct {
+	// Name renames the Volume Group.
+	Name *string
+	// Quota sets the capacity quota of the Volume Group. A value of 0 removes the quota.
+	Quota *Capacity
+	// CapacityPolicy assigns the Capacity Policy with the provided name.
+	CapacityPolicy *string
+	// Description replaces the description of the Volume Group.
+	Description *string
+}
+
 // UpdateVolumeGroup updates the Volume Group with the provided config options.
 //
-// Valid config keys are: name, quota, capacityPolicy, and description.
+// Valid config keys are: name, quota, quotaInGb, capacityPolicy, and description.
+//
+// Deprecated: Use ApplyVolumeGroupUpdate() and a VolumeGroupUpdate, which are validated at compile time.
 func (c *Credentials) UpdateVolumeGroup(name string, config map[string]interface{}, timeout ...int) (*CreateOrUpdateVolumeGroupResponse, error) {
+	return c.UpdateVolumeGroupCtx(context.Background(), name, config, timeout...)
+}
+
+// UpdateVolumeGroupCtx is the context-aware form of UpdateVolumeGroup.
+//
+// Deprecated: Use ApplyVolumeGroupUpdateCtx() and a VolumeGroupUpdate, which are validated at compile time.
+func (c *Credentials) UpdateVolumeGroupCtx(ctx context.Context, name string, config map[string]interface{}, timeout ...int) (*CreateOrUpdateVolumeGroupResponse, error) {
 	httpTimeout := httpTimeout(timeout)
 
 	if _, ok := config["quotaInGb"]; ok {
-		config["quota"] = config["quotaInGb"].(int) * 1024 * 1024
+		config["quota"] = GiB(int64(config["quotaInGb"].(int)))
 		delete(config, "quotaInGb")
 	}
 
@@ -71,7 +125,6 @@ func (c *Credentials) UpdateVolumeGroup(name string, config map[string]interface
 	validUpdateKeys := []string{"name", "quota", "capacityPolicy", "description"}
 	var invalidUserProvidedKeys []string
 	for key := range config {
-
 		if c.stringInSlice(validUpdateKeys, key) == false {
 			invalidUserProvidedKeys = append(invalidUserProvidedKeys, key)
 		}
@@ -82,19 +135,61 @@ func (c *Credentials) UpdateVolumeGroup(name string, config map[string]interface
 		return nil, fmt.Errorf("The provided 'config' parameter contains invalid keys. 'name', 'quota', 'capacityPolicy', and 'description' are the only valid choices")
 	}
 
-	volumeGroupID, err := c.GetVolumeGroupID(name, httpTimeout)
+	return c.updateVolumeGroup(ctx, name, config, httpTimeout)
+}
+
+// ApplyVolumeGroupUpdate applies the provided changes to a Volume Group. Only the fields of the update that are set are
+// sent to the Silk server, and an error is returned if none are.
+func (c *Credentials) ApplyVolumeGroupUpdate(name string, update VolumeGroupUpdate, timeout ...int) (*CreateOrUpdateVolumeGroupResponse, error) {
+	return c.ApplyVolumeGroupUpdateCtx(context.Background(), name, update, timeout...)
+}
+
+// ApplyVolumeGroupUpdateCtx is the context-aware form of ApplyVolumeGroupUpdate.
+func (c *Credentials) ApplyVolumeGroupUpdateCtx(ctx context.Context, name string, update VolumeGroupUpdate, timeout ...int) (*CreateOrUpdateVolumeGroupResponse, error) {
+
+	httpTimeout := httpTimeout(timeout)
+
+	config := map[string]interface{}{}
+	if update.Name != nil {
+		config["name"] = *update.Name
+	}
+	if update.Quota != nil {
+		config["quota"] = *update.Quota
+	}
+	if update.CapacityPolicy != nil {
+		capacityPolicyID, err := c.GetCapacityPolicyIDCtx(ctx, *update.CapacityPolicy, httpTimeout)
+		if err != nil {
+			return nil, err
+		}
+		config["capacity_policy"] = CapacityPolicyRef(capacityPolicyID)
+	}
+	if update.Description != nil {
+		config["description"] = *update.Description
+	}
+
+	if len(config) == 0 {
+		return nil, errNoChanges
+	}
+
+	return c.updateVolumeGroup(ctx, name, config, httpTimeout)
+}
+
+// updateVolumeGroup sends the PATCH request shared by UpdateVolumeGroupCtx() and ApplyVolumeGroupUpdateCtx().
+func (c *Credentials) updateVolumeGroup(ctx context.Context, name string, config map[string]interface{}, httpTimeout int) (*CreateOrUpdateVolumeGroupResponse, error) {
+
+	volumeGroupID, err := c.GetVolumeGroupIDCtx(ctx, name, httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 
-	apiRequest, err := c.Patch(fmt.Sprintf("/volume_groups/%d", volumeGroupID), config, httpTimeout)
+	apiRequest, err := c.PatchCtx(ctx, fmt.Sprintf("/volume_groups/%d", volumeGroupID), config, httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 
 	// Convert the API Response (map[string]interface{}) to a struct
 	var apiResponse CreateOrUpdateVolumeGroupResponse
-	mapErr := mapstructure.Decode(apiRequest, &apiResponse)
+	mapErr := decode(apiRequest, &apiResponse)
 	if mapErr != nil {
 		return nil, mapErr
 	}
@@ -105,22 +200,27 @@ func (c *Credentials) UpdateVolumeGroup(name string, config map[string]interface
 
 // DeleteVolumeGroup deletes a Volume Group from the Silk server.
 func (c *Credentials) DeleteVolumeGroup(name string, timeout ...int) (*DeleteResponse, error) {
+	return c.DeleteVolumeGroupCtx(context.Background(), name, timeout...)
+}
+
+// DeleteVolumeGroupCtx is the context-aware form of DeleteVolumeGroup.
+func (c *Credentials) DeleteVolumeGroupCtx(ctx context.Context, name string, timeout ...int) (*DeleteResponse, error) {
 
 	httpTimeout := httpTimeout(timeout)
 
-	volumeGroupID, err := c.GetVolumeGroupID(name, httpTimeout)
+	volumeGroupID, err := c.GetVolumeGroupIDCtx(ctx, name, httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 
-	apiRequest, err := c.Delete(fmt.Sprintf("/volume_groups/%d", volumeGroupID), httpTimeout)
+	apiRequest, err := c.DeleteCtx(ctx, fmt.Sprintf("/volume_groups/%d", volumeGroupID), httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 
 	// Convert the API Response (map[string]interface{}) to a struct
 	var apiResponse DeleteResponse
-	mapErr := mapstructure.Decode(apiRequest, &apiResponse)
+	mapErr := decode(apiRequest, &apiResponse)
 	if mapErr != nil {
 		return nil, mapErr
 	}
@@ -131,104 +231,63 @@ func (c *Credentials) DeleteVolumeGroup(name string, timeout ...int) (*DeleteRes
 
 // GetVolumeGroupID provides the ID for the provided Volume Group name.
 func (c *Credentials) GetVolumeGroupID(name string, timeout ...int) (int, error) {
+	return c.GetVolumeGroupIDCtx(context.Background(), name, timeout...)
+}
 
-	httpTimeout := httpTimeout(timeout)
-
-	allVolumeGroups, err := c.GetVolumeGroups(httpTimeout)
-	if err != nil {
-		return 0, err
-	}
-
-	// Set volumeGroupID to a value (-1) that can not be returned by the server
-	volumeGroupID := -1
-	for _, volumeGroup := range allVolumeGroups.Hits {
-		if volumeGroup.Name == name {
-			volumeGroupID = volumeGroup.ID
-		}
-
-	}
-
-	// If the volumeGroupID has not been updated (i.e not found on the server) return an error message
-	if volumeGroupID == -1 {
-		return 0, fmt.Errorf("The server does not contain a Volume Group named '%s'", name)
-	}
+// GetVolumeGroupIDCtx is the context-aware form of GetVolumeGroupID.
+func (c *Credentials) GetVolumeGroupIDCtx(ctx context.Context, name string, timeout ...int) (int, error) {
 
-	return volumeGroupID, nil
+	httpTimeout := httpTimeout(timeout)
 
+	return c.lookupID(ctx, KindVolumeGroup, name, httpTimeout)
 }
 
 // GetCapacityPolicyName returns the name of the Capacity Police based on the provided Capacity Policy id.
 func (c *Credentials) GetCapacityPolicyName(id int, timeout ...int) (string, error) {
+	return c.GetCapacityPolicyNameCtx(context.Background(), id, timeout...)
+}
+
+// GetCapacityPolicyNameCtx is the context-aware form of GetCapacityPolicyName.
+func (c *Credentials) GetCapacityPolicyNameCtx(ctx context.Context, id int, timeout ...int) (string, error) {
 
 	httpTimeout := httpTimeout(timeout)
 
-	apiRequest, err := c.Get("/vg_capacity_policies", httpTimeout)
+	names, err := c.resolveNames(ctx, KindCapacityPolicy, []int{id}, httpTimeout)
 	if err != nil {
 		return "", err
 	}
 
-	// Convert the API Response (map[string]interface{}) to a struct
-	var apiResponse GetCapacityPolicyResponse
-	mapErr := mapstructure.Decode(apiRequest, &apiResponse)
-	if mapErr != nil {
-		return "", mapErr
-	}
-
-	capacityPolicyName := ""
-	for _, capacityPolicy := range apiResponse.Hits {
-		if capacityPolicy.ID == id {
-			capacityPolicyName = capacityPolicy.Name
-		}
-	}
-
-	// If the capacityPolicyName has not been updated (i.e not found on the server) return an error message
-	if capacityPolicyName == "" {
-		return "", fmt.Errorf("The server does not contain a Capacity Policy with the ID of '%d'", id)
-	}
-
-	return capacityPolicyName, nil
+	return names[id], nil
 }
 
 // GetVolumeGroupHostMappings returns all Hosts that are mapped to the provided Volume Group.
 func (c *Credentials) GetVolumeGroupHostMappings(volumeGroupName string, timeout ...int) ([]string, error) {
+	return c.GetVolumeGroupHostMappingsCtx(context.Background(), volumeGroupName, timeout...)
+}
+
+// GetVolumeGroupHostMappingsCtx is the context-aware form of GetVolumeGroupHostMappings.
+func (c *Credentials) GetVolumeGroupHostMappingsCtx(ctx context.Context, volumeGroupName string, timeout ...int) ([]string, error) {
 
 	httpTimeout := httpTimeout(timeout)
 
-	volumeGroupID, err := c.GetVolumeGroupID(volumeGroupName)
+	volumeGroupID, err := c.GetVolumeGroupIDCtx(ctx, volumeGroupName)
 	if err != nil {
 		return nil, err
 	}
 
-	hostMappingsOnServer, err := c.GetHostMappings(httpTimeout)
+	hostMappingsOnServer, err := c.GetHostMappingsCtx(ctx, httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 
 	// Filter out the user provided volume and host from the hostMappingsOnServer
 	// results
-	hostName := []string{}
+	hostIDs := []int{}
 
 	for _, mapping := range hostMappingsOnServer {
-		if mapping.Volume.Ref == fmt.Sprintf("/volume_groups/%d", volumeGroupID) {
-			var hostID int
-			if strings.Contains(mapping.Host.Ref, "/hosts") == true {
-
-				hostRefID := strings.Replace(mapping.Host.Ref, "/hosts/", "", 1)
-				hostID, err = strconv.Atoi(hostRefID)
-				if err != nil {
-					return nil, err
-				}
-
-				name, err := c.GetHostName(hostID)
-				if err != nil {
-					return nil, err
-				}
-
-				hostName = append(hostName, name)
-			}
-
+		if mapping.Volume == VolumeGroupRef(volumeGroupID) && mapping.Host.Kind() == KindHost {
+			hostIDs = append(hostIDs, mapping.Host.ID())
 		}
-
 	}
 
 	// // If the mappingID has not been updated (i.e not found on the server) return an error message
@@ -236,27 +295,43 @@ func (c *Credentials) GetVolumeGroupHostMappings(volumeGroupName string, timeout
 	// 	return nil, fmt.Errorf("No Host Mappings found on the Volume Group '%s'", volumeGroupName)
 	// }
 
+	// Resolve every name in a single request rather than one lookup per mapping
+	names, err := c.resolveNames(ctx, KindHost, hostIDs, httpTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	hostName := []string{}
+	for _, id := range hostIDs {
+		hostName = append(hostName, names[id])
+	}
+
 	return hostName, nil
 }
 
 // GetVolumeGroupVolumes provides the name of every Volume in a Volume Group.
 func (c *Credentials) GetVolumeGroupVolumes(name string, timeout ...int) ([]string, error) {
+	return c.GetVolumeGroupVolumesCtx(context.Background(), name, timeout...)
+}
+
+// GetVolumeGroupVolumesCtx is the context-aware form of GetVolumeGroupVolumes.
+func (c *Credentials) GetVolumeGroupVolumesCtx(ctx context.Context, name string, timeout ...int) ([]string, error) {
 
 	httpTimeout := httpTimeout(timeout)
 
-	volumeGroupID, err := c.GetVolumeGroupID(name)
+	volumeGroupID, err := c.GetVolumeGroupIDCtx(ctx, name)
 	if err != nil {
 		return nil, err
 	}
 
-	allVolumes, err := c.GetVolumes(httpTimeout)
+	allVolumes, err := c.GetVolumesCtx(ctx, httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 
 	volumes := []string{}
 	for _, volume := range allVolumes.Hits {
-		if string(volume.VolumeGroup.Ref) == fmt.Sprintf("/volume_groups/%d", volumeGroupID) {
+		if volume.VolumeGroup == VolumeGroupRef(volumeGroupID) {
 			volumes = append(volumes, volume.Name)
 		}
 	}
@@ -268,24 +343,28 @@ func (c *Credentials) GetVolumeGroupVolumes(name string, timeout ...int) ([]stri
 	return volumes, nil
 
 }
+
 // GetVolumeGroupByName returns information on all Volume Groups found on the Silk server.
 func (c *Credentials) GetVolumeGroupByName(volumegroupname string, timeout ...int) (*GetVolumeGroupsResponse, error) {
+	return c.GetVolumeGroupByNameCtx(context.Background(), volumegroupname, timeout...)
+}
 
-	httpTimeout := httpTimeout(timeout)
+// GetVolumeGroupByNameCtx is the context-aware form of GetVolumeGroupByName.
+func (c *Credentials) GetVolumeGroupByNameCtx(ctx context.Context, volumegroupname string, timeout ...int) (*GetVolumeGroupsResponse, error) {
 
-	enduri := ("/volume_groups?name__contains=" + volumegroupname)
+	httpTimeout := httpTimeout(timeout)
 
-	apiRequest, err := c.Get(enduri, httpTimeout)
+	apiRequest, err := c.getAllCtx(ctx, "/volume_groups", Filter().Name().Contains(volumegroupname), httpTimeout)
 	if err != nil {
 		return nil, err
 	}
 
 	// Convert the API Response (map[string]interface{}) to a struct
 	var apiResponse GetVolumeGroupsResponse
-	mapErr := mapstructure.Decode(apiRequest, &apiResponse)
+	mapErr := decode(apiRequest, &apiResponse)
 	if mapErr != nil {
 		return nil, mapErr
 	}
 
 	return &apiResponse, nil
-}
\ No newline at end of file
+}
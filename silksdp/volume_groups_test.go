@@ -88,4 +88,4 @@ func Test_LifecycleVolumeGroup(t *testing.T) {
 	Test_UpdateVolumeGroup(t)
 	Test_GetVolumeGroups(t)
 	Test_DeleteVolumeGroup(t)
-}
\ No newline at end of file
+}
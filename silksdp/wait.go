@@ -0,0 +1,103 @@
+package silksdp
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const (
+	// defaultPollInterval is the pause between two checks of a WaitCondition when WithPollInterval() has not been used.
+	defaultPollInterval = 500 * time.Millisecond
+	// defaultWaitTimeout bounds WaitFor() when the provided context does not carry a deadline.
+	defaultWaitTimeout = time.Minute
+)
+
+// WaitCondition reports whether the state being waited on has been reached. Returning an error stops the wait.
+type WaitCondition func(ctx context.Context) (bool, error)
+
+// WaitFor polls condition until it returns true, returns an error, or ctx is done. Most calls do not need to wait on the
+// Silk server, WaitFor is meant for the cases where a change must be visible before the next step runs (ex: looking up an
+// object by name right after it has been created).
+//
+// When ctx does not carry a deadline, WaitFor gives up after one minute.
+func (c *Credentials) WaitFor(ctx context.Context, condition WaitCondition) error {
+
+	if _, ok := ctx.Deadline(); ok == false {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, defaultWaitTimeout)
+		defer cancel()
+	}
+
+	interval := c.pollInterval
+	if interval == 0 {
+		interval = defaultPollInterval
+	}
+
+	for {
+		done, err := condition(ctx)
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+		if err := sleepCtx(ctx, interval); err != nil {
+			return err
+		}
+	}
+}
+
+// WaitForHost waits until a Host with the provided name is returned by the Silk server.
+func (c *Credentials) WaitForHost(ctx context.Context, name string) error {
+	return c.waitForName(ctx, "/hosts", "Host", name)
+}
+
+// WaitForHostGroup waits until a Host Group with the provided name is returned by the Silk server.
+func (c *Credentials) WaitForHostGroup(ctx context.Context, name string) error {
+	return c.waitForName(ctx, "/host_groups", "Host Group", name)
+}
+
+// WaitForVolume waits until a Volume with the provided name is returned by the Silk server.
+func (c *Credentials) WaitForVolume(ctx context.Context, name string) error {
+	return c.waitForName(ctx, "/volumes", "Volume", name)
+}
+
+// WaitForVolumeGroup waits until a Volume Group with the provided name is returned by the Silk server.
+func (c *Credentials) WaitForVolumeGroup(ctx context.Context, name string) error {
+	return c.waitForName(ctx, "/volume_groups", "Volume Group", name)
+}
+
+// waitForName polls the provided collection until it contains an object with the provided name.
+func (c *Credentials) waitForName(ctx context.Context, collection, kind, name string) error {
+
+	err := c.WaitFor(ctx, func(ctx context.Context) (bool, error) {
+		apiRequest, err := c.getQueryCtx(ctx, collection, Filter().Name().In(name).Encode(), httpTimeout(nil))
+		if err != nil {
+			return false, err
+		}
+
+		// Only the names are needed to know whether the object is visible
+		var apiResponse struct {
+			Hits []struct {
+				Name string `mapstructure:"name"`
+			} `mapstructure:"hits"`
+		}
+		mapErr := decode(apiRequest, &apiResponse)
+		if mapErr != nil {
+			return false, mapErr
+		}
+
+		for _, object := range apiResponse.Hits {
+			if object.Name == name {
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+	if err != nil {
+		return fmt.Errorf("The %s '%s' did not become visible on the Silk server: %w", kind, name, err)
+	}
+
+	return nil
+}
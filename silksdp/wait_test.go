@@ -0,0 +1,33 @@
+package silksdp
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func Test_WaitForHost(t *testing.T) {
+	var calls int
+	silk := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			w.Write([]byte(`{"hits": [], "total": 0}`))
+			return
+		}
+		w.Write([]byte(`{"hits": [{"id": 7, "name": "new-host"}], "total": 1}`))
+	}, WithPollInterval(10*time.Millisecond))
+
+	if err := silk.WaitForHost(context.Background(), "new-host"); err != nil {
+		t.Errorf("Failed to wait for host: %v", err)
+	}
+	if calls != 3 {
+		t.Errorf("Expected 3 polls, got %d", calls)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := silk.WaitForHost(ctx, "missing-host"); err == nil {
+		t.Errorf("Expected waiting for a missing host to time out")
+	}
+}